@@ -6,6 +6,7 @@ package main
 // Based on https://github.com/kubernetes-sigs/controller-runtime/blob/8f633b179e1c704a6e40440b528252f147a3362a/examples/builtins/main.go
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"os"
@@ -15,7 +16,9 @@ import (
 	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
 	sg2v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen2/v1alpha1"
 	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	sg2client "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client2/clientset/versioned"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/generator"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/rollout"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/satoken"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/sharing"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/tracker"
@@ -33,18 +36,26 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
+const (
+	// Namespace controller runs in (set via downward API)
+	controllerNamespaceEnvKey = "SECRETGEN_CONTROLLER_NAMESPACE"
+)
+
 var (
 	// Version of secretgen-controller is set via ldflags at build-time from most recent git tag
 	Version = "develop"
 
-	log                = logf.Log.WithName("sg")
-	ctrlNamespace      = ""
-	metricsBindAddress = ""
+	log = logf.Log.WithName("sg")
+
+	ctrlNamespace         = ""
+	metricsBindAddress    = ""
+	enableRolloutTriggers = false
 )
 
 func main() {
 	flag.StringVar(&ctrlNamespace, "namespace", "", "Namespace to watch")
 	flag.StringVar(&metricsBindAddress, "metrics-bind-address", ":8080", "Address for metrics server. If 0, then metrics server doesnt listen on any port.")
+	flag.BoolVar(&enableRolloutTriggers, "enable-rollout-triggers", false, "Roll out workloads annotated with secretgen.carvel.dev/rollout-on-secret-change when referenced Secrets change (requires RBAC access to deployments, statefulsets and daemonsets).")
 	flag.Parse()
 
 	logf.SetLogger(zap.New(zap.UseDevMode(false)))
@@ -69,18 +80,73 @@ func main() {
 	sgClient, err := sgclient.NewForConfig(restConfig)
 	exitIfErr(entryLog, "building secretgen client", err)
 
+	sg2Client, err := sg2client.NewForConfig(restConfig)
+	exitIfErr(entryLog, "building secretgen2 client", err)
+
+	digester, err := loadSecretDigester(coreClient, entryLog)
+	exitIfErr(entryLog, "loading digest key", err)
+
+	ageKeyReconciler := generator.NewAgeKeyReconciler(sgClient, coreClient, log.WithName("agekey"))
+	exitIfErr(entryLog, "registering", registerCtrl("agekey", mgr, ageKeyReconciler))
+
 	certReconciler := generator.NewCertificateReconciler(sgClient, coreClient, log.WithName("cert"))
 	exitIfErr(entryLog, "registering", registerCtrl("cert", mgr, certReconciler))
 
-	passwordReconciler := generator.NewPasswordReconciler(sgClient, coreClient, log.WithName("password"))
+	openPGPKeyReconciler := generator.NewOpenPGPKeyReconciler(sgClient, coreClient, log.WithName("openpgpkey"))
+	exitIfErr(entryLog, "registering", registerCtrl("openpgpkey", mgr, openPGPKeyReconciler))
+
+	passwordReconciler := generator.NewPasswordReconciler(sgClient, coreClient, digester, log.WithName("password"))
 	exitIfErr(entryLog, "registering", registerCtrl("password", mgr, passwordReconciler))
 
-	rsaKeyReconciler := generator.NewRSAKeyReconciler(sgClient, coreClient, log.WithName("rsakey"))
+	randomTokenReconciler := generator.NewRandomTokenReconciler(sgClient, coreClient, log.WithName("randomtoken"))
+	exitIfErr(entryLog, "registering", registerCtrl("randomtoken", mgr, randomTokenReconciler))
+
+	rsaKeyReconciler := generator.NewRSAKeyReconciler(sgClient, coreClient, digester, log.WithName("rsakey"))
 	exitIfErr(entryLog, "registering", registerCtrl("rsakey", mgr, rsaKeyReconciler))
 
-	sshKeyReconciler := generator.NewSSHKeyReconciler(sgClient, coreClient, log.WithName("sshkey"))
+	keyPairReconciler := generator.NewKeyPairReconciler(sgClient, coreClient, log.WithName("keypair"))
+	exitIfErr(entryLog, "registering", registerCtrl("keypair", mgr, keyPairReconciler))
+
+	sshKeyReconciler := generator.NewSSHKeyReconciler(sgClient, coreClient, digester, log.WithName("sshkey"))
 	exitIfErr(entryLog, "registering", registerCtrl("sshkey", mgr, sshKeyReconciler))
 
+	sshCertReconciler := generator.NewSSHCertificateReconciler(sgClient, coreClient, tracker.NewTracker(), log.WithName("sshcert"))
+	exitIfErr(entryLog, "registering", registerCtrl("sshcert", mgr, sshCertReconciler))
+
+	tlsPairReconciler := generator.NewTLSPairReconciler(sgClient, sg2Client, coreClient, log.WithName("tlspair"))
+	exitIfErr(entryLog, "registering", registerCtrl("tlspair", mgr, tlsPairReconciler))
+
+	totpSeedReconciler := generator.NewTOTPSeedReconciler(sgClient, coreClient, log.WithName("totpseed"))
+	exitIfErr(entryLog, "registering", registerCtrl("totpseed", mgr, totpSeedReconciler))
+
+	jwksReconciler := generator.NewJSONWebKeySetReconciler(sgClient, coreClient, log.WithName("jwks"))
+	exitIfErr(entryLog, "registering", registerCtrl("jwks", mgr, jwksReconciler))
+
+	jwtReconciler := generator.NewJSONWebTokenReconciler(sgClient, coreClient, tracker.NewTracker(), log.WithName("jwt"))
+	exitIfErr(entryLog, "registering", registerCtrl("jwt", mgr, jwtReconciler))
+
+	dhParamReconciler := generator.NewDHParamReconciler(sgClient, coreClient, log.WithName("dhparam"))
+	exitIfErr(entryLog, "registering", registerCtrl("dhparam", mgr, dhParamReconciler))
+
+	dockerConfigReconciler := generator.NewDockerConfigReconciler(sgClient, coreClient, tracker.NewTracker(), log.WithName("dockerconfig"))
+	exitIfErr(entryLog, "registering", registerCtrl("dockerconfig", mgr, dockerConfigReconciler))
+
+	keyringReconciler := generator.NewEncryptionKeyringReconciler(sgClient, coreClient, log.WithName("keyring"))
+	exitIfErr(entryLog, "registering", registerCtrl("keyring", mgr, keyringReconciler))
+
+	htpasswdReconciler := generator.NewHtpasswdReconciler(sgClient, coreClient, tracker.NewTracker(), log.WithName("htpasswd"))
+	exitIfErr(entryLog, "registering", registerCtrl("htpasswd", mgr, htpasswdReconciler))
+
+	wgKeyReconciler := generator.NewWireGuardKeyReconciler(sgClient, coreClient, log.WithName("wgkey"))
+	exitIfErr(entryLog, "registering", registerCtrl("wgkey", mgr, wgKeyReconciler))
+
+	wgConfigReconciler := generator.NewWireGuardConfigReconciler(sgClient, coreClient, tracker.NewTracker(), log.WithName("wgconfig"))
+	exitIfErr(entryLog, "registering", registerCtrl("wgconfig", mgr, wgConfigReconciler))
+
+	saKubeconfigReconciler := generator.NewServiceAccountKubeconfigReconciler(sgClient, coreClient,
+		satoken.NewManager(coreClient, log.WithName("sakubeconfig")), tracker.NewTracker(), log.WithName("sakubeconfig"))
+	exitIfErr(entryLog, "registering", registerCtrl("sakubeconfig", mgr, saKubeconfigReconciler))
+
 	saLoader := generator.NewServiceAccountLoader(satoken.NewManager(coreClient, log.WithName("template")))
 
 	// Set SecretTemplate's maximum exponential to reduce reconcile time for inputresource errors
@@ -106,12 +172,29 @@ func main() {
 		exitIfErr(entryLog, "registering", registerCtrl("secret", mgr, secretReconciler))
 	}
 
+	if enableRolloutTriggers {
+		workloadReconciler := rollout.NewWorkloadReconciler(mgr.GetClient(), log.WithName("rollout"))
+		exitIfErr(entryLog, "registering", registerCtrl("rollout", mgr, workloadReconciler))
+	}
+
 	entryLog.Info("starting manager")
 
 	err = mgr.Start(signals.SetupSignalHandler())
 	exitIfErr(entryLog, "unable to run manager", err)
 }
 
+// loadSecretDigester loads key used to digest generated values from
+// controller's namespace. Controller running outside of a cluster
+// (namespace is not known) uses a key that is not persisted.
+func loadSecretDigester(coreClient kubernetes.Interface, entryLog logr.Logger) (generator.SecretDigester, error) {
+	namespace := os.Getenv(controllerNamespaceEnvKey)
+	if len(namespace) == 0 {
+		entryLog.Info("using non-persisted digest key since controller namespace is not known", "env", controllerNamespaceEnvKey)
+		return generator.NewEphemeralSecretDigester()
+	}
+	return generator.LoadSecretDigester(context.Background(), coreClient, namespace)
+}
+
 type reconcilerWithWatches interface {
 	reconcile.Reconciler
 	AttachWatches(controller.Controller) error
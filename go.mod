@@ -3,13 +3,18 @@ module github.com/vmware-tanzu/carvel-secretgen-controller
 go 1.21
 
 require (
+	filippo.io/age v1.0.0
+	github.com/ProtonMail/go-crypto v1.0.0
 	github.com/cloudfoundry/bosh-utils v0.0.362 // indirect
 	github.com/cloudfoundry/config-server v0.1.118
 	github.com/ghodss/yaml v1.0.0
 	github.com/go-logr/logr v1.2.4
+	github.com/skip2/go-qrcode v0.0.0-20200617195104-da1b6568686e
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/crypto v0.17.0
 	golang.org/x/tools v0.8.0 // indirect
 	k8s.io/api v0.26.2
+	k8s.io/apiextensions-apiserver v0.26.1
 	k8s.io/apimachinery v0.27.1
 	k8s.io/client-go v0.26.2
 	k8s.io/code-generator v0.27.1
@@ -21,6 +26,7 @@ require (
 require (
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.1.2 // indirect
+	github.com/cloudflare/circl v1.3.3 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/emicklei/go-restful/v3 v3.10.1 // indirect
 	github.com/evanphx/json-patch v5.6.0+incompatible // indirect
@@ -61,7 +67,6 @@ require (
 	go.uber.org/atomic v1.10.0 // indirect
 	go.uber.org/multierr v1.8.0 // indirect
 	go.uber.org/zap v1.24.0 // indirect
-	golang.org/x/crypto v0.17.0 // indirect
 	golang.org/x/mod v0.10.0 // indirect
 	golang.org/x/net v0.17.0 // indirect
 	golang.org/x/oauth2 v0.0.0-20220808172628-8227340efae7 // indirect
@@ -75,7 +80,6 @@ require (
 	gopkg.in/inf.v0 v0.9.1 // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
-	k8s.io/apiextensions-apiserver v0.26.1 // indirect
 	k8s.io/component-base v0.26.1 // indirect
 	k8s.io/gengo v0.0.0-20220902162205-c0856e24416d // indirect
 	k8s.io/klog/v2 v2.90.1 // indirect
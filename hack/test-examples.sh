@@ -2,13 +2,43 @@
 
 set -e -x -u
 
+time kapp deploy -y -a age-key -f examples/age-key.yml
+time kapp delete -y -a age-key
+
 time kapp deploy -y -a certs -f examples/certs.yml
 time kapp deploy -y -a certs -f examples/certs-rotation
 time kapp delete -y -a certs
 
+time kapp deploy -y -a dh-param -f examples/dh-param.yml
+time kapp delete -y -a dh-param
+
+time kapp deploy -y -a docker-config -f examples/docker-config.yml
+time kapp delete -y -a docker-config
+
+time kapp deploy -y -a encryption-keyring -f examples/encryption-keyring.yml
+time kapp delete -y -a encryption-keyring
+
+time kapp deploy -y -a htpasswd -f examples/htpasswd.yml
+time kapp delete -y -a htpasswd
+
+time kapp deploy -y -a json-web-key-set -f examples/json-web-key-set.yml
+time kapp delete -y -a json-web-key-set
+
+time kapp deploy -y -a json-web-token -f examples/json-web-token.yml
+time kapp delete -y -a json-web-token
+
+time kapp deploy -y -a key-pair -f examples/key-pair.yml
+time kapp delete -y -a key-pair
+
+time kapp deploy -y -a open-pgp-key -f examples/open-pgp-key.yml
+time kapp delete -y -a open-pgp-key
+
 time kapp deploy -y -a passwords -f examples/passwords.yml
 time kapp delete -y -a passwords
 
+time kapp deploy -y -a random-token -f examples/random-token.yml
+time kapp delete -y -a random-token
+
 time kapp deploy -y -a rsa-key -f examples/rsa-key.yml
 time kapp delete -y -a rsa-key
 
@@ -18,9 +48,24 @@ time kapp delete -y -a secret-export-image-pull-secret
 time kapp deploy -y -a secret-export -f examples/secret-export.yml
 time kapp delete -y -a secret-export
 
+time kapp deploy -y -a service-account-kubeconfig -f examples/service-account-kubeconfig.yml
+time kapp delete -y -a service-account-kubeconfig
+
 time kapp deploy -y -a ssh-key -f examples/ssh-key.yml
 time kapp delete -y -a ssh-key
 
+time kapp deploy -y -a ssh-certificate -f examples/ssh-certificate.yml
+time kapp delete -y -a ssh-certificate
+
+time kapp deploy -y -a tls-pair -f examples/tls-pair.yml
+time kapp delete -y -a tls-pair
+
+time kapp deploy -y -a totp-seed -f examples/totp-seed.yml
+time kapp delete -y -a totp-seed
+
+time kapp deploy -y -a wireguard -f examples/wireguard.yml
+time kapp delete -y -a wireguard
+
 time kapp deploy -y -a secret-template -f examples/secret-template.yml
 time kapp delete -y -a secret-template
 
@@ -20,14 +20,46 @@ var (
 func init() {
 	localSchemeBuilder.Register(func(scheme *runtime.Scheme) error {
 		scheme.AddKnownTypes(SchemeGroupVersion,
+			&AgeKey{},
+			&AgeKeyList{},
 			&Certificate{},
 			&CertificateList{},
+			&DHParam{},
+			&DHParamList{},
+			&DockerConfig{},
+			&DockerConfigList{},
+			&EncryptionKeyring{},
+			&EncryptionKeyringList{},
+			&Htpasswd{},
+			&HtpasswdList{},
+			&JSONWebKeySet{},
+			&JSONWebKeySetList{},
+			&JSONWebToken{},
+			&JSONWebTokenList{},
+			&KeyPair{},
+			&KeyPairList{},
+			&OpenPGPKey{},
+			&OpenPGPKeyList{},
 			&Password{},
 			&PasswordList{},
+			&RandomToken{},
+			&RandomTokenList{},
 			&RSAKey{},
 			&RSAKeyList{},
+			&SSHCertificate{},
+			&SSHCertificateList{},
 			&SSHKey{},
 			&SSHKeyList{},
+			&ServiceAccountKubeconfig{},
+			&ServiceAccountKubeconfigList{},
+			&TLSPair{},
+			&TLSPairList{},
+			&TOTPSeed{},
+			&TOTPSeedList{},
+			&WireGuardConfig{},
+			&WireGuardConfigList{},
+			&WireGuardKey{},
+			&WireGuardKeyList{},
 		)
 		scheme.AddKnownTypes(SchemeGroupVersion, &metav1.Status{})
 		metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
@@ -14,6 +14,9 @@ type SecretTemplate struct {
 	Type corev1.SecretType `json:"type,omitempty"`
 	// +optional
 	StringData map[string]string `json:"stringData,omitempty"`
+	// Create Secret as immutable. Secret is recreated when its data changes.
+	// +optional
+	Immutable bool `json:"immutable,omitempty"`
 }
 
 type SecretTemplateMetadata struct {
@@ -3,6 +3,10 @@
 
 package v1alpha1
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 type GenericStatus struct {
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration"`
@@ -11,3 +15,32 @@ type GenericStatus struct {
 	// +optional
 	FriendlyDescription string `json:"friendlyDescription"`
 }
+
+const (
+	SecretDriftReasonDeleted  = "Deleted"
+	SecretDriftReasonModified = "Modified"
+)
+
+// SecretDriftStatus describes last time generated Secret was found
+// deleted or modified outside of secretgen-controller and was repaired.
+type SecretDriftStatus struct {
+	// Deleted or Modified
+	Reason string `json:"reason"`
+	// Secret keys that were found changed, added or removed
+	// +optional
+	Keys []string `json:"keys,omitempty"`
+	// Whether previously generated values were kept
+	// (otherwise new values were generated)
+	ValuesPreserved bool `json:"valuesPreserved"`
+	// +optional
+	DetectedAt metav1.Time `json:"detectedAt,omitempty"`
+}
+
+// RotationStatus describes last rotation requested via
+// rotate annotation that was processed by secretgen-controller.
+type RotationStatus struct {
+	// Value of rotate annotation that triggered regeneration
+	Token string `json:"token"`
+	// +optional
+	RotatedAt metav1.Time `json:"rotatedAt,omitempty"`
+}
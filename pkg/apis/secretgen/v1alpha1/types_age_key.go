@@ -0,0 +1,66 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	AgeKeySecretIdentityKey  = "identity"
+	AgeKeySecretRecipientKey = "recipient"
+
+	AgeKeySecretDefaultType         = corev1.SecretTypeOpaque
+	AgeKeySecretDefaultIdentityKey  = "key.txt"
+	AgeKeySecretDefaultRecipientKey = "recipient.txt"
+)
+
+// AgeKey generates X25519 identity for age file encryption tool.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Recipient,JSONPath=.status.recipient,description=Recipient (public key),type=string
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type AgeKey struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec AgeKeySpec `json:"spec"`
+	// +optional
+	Status AgeKeyStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AgeKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []AgeKey `json:"items"`
+}
+
+type AgeKeySpec struct {
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type AgeKeyStatus struct {
+	GenericStatus `json:",inline"`
+
+	// Recipient (age1...) that could be used to encrypt for generated identity
+	// +optional
+	Recipient string `json:"recipient,omitempty"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+}
@@ -9,8 +9,12 @@ import (
 )
 
 const (
-	CertificateSecretCertificateKey = "certificate"
-	CertificateSecretPrivateKeyKey  = "privateKey"
+	CertificateSecretCertificateKey     = "certificate"
+	CertificateSecretPrivateKeyKey      = "privateKey"
+	CertificateSecretCombinedPEMKey     = "combinedPem"
+	CertificateSecretCertificateDERKey  = "certificateDer"
+	CertificateSecretPrivateKeyPKCS8Key = "privateKeyPkcs8"
+	CertificateSecretPublicKeyKey       = "publicKey"
 
 	CertificateSecretDefaultType           = corev1.SecretTypeOpaque
 	CertificateSecretDefaultCertificateKey = "crt.pem"
@@ -29,6 +33,9 @@ type Certificate struct {
 	// +optional
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
+	// Certificate could be reissued via secretgen.carvel.dev/rotate
+	// annotation. Unlike Password, RSAKey and SSHKey there is no
+	// rotationGracePeriod: replaced certificate and key are not kept.
 	// +optional
 	Spec CertificateSpec `json:"spec"`
 	// +optional
@@ -61,13 +68,32 @@ type CertificateSpec struct {
 	AlternativeNames []string `json:"alternativeNames,omitempty"`
 	// +optional
 	ExtendedKeyUsage []string `json:"extendedKeyUsage,omitempty"`
+	// Deprecated: use validity instead.
+	// Number of days certificate is valid for.
 	// +optional
 	Duration int64 `json:"duration,omitempty"`
+	// Validity of certificate as a duration (e.g. "2160h" or "90d").
+	// Takes precedence over duration.
+	// +optional
+	Validity string `json:"validity,omitempty"`
+	// Backdates NotBefore of certificate by given duration
+	// to tolerate clock skew between systems (e.g. "5m").
+	// +optional
+	NotBeforeSkew string `json:"notBeforeSkew,omitempty"`
 
 	// +optional
 	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+
+	// Take ownership of already existing Secret with the same name
+	// keeping its values (e.g. when migrating to secretgen-controller).
+	// Secret is expected to contain all keys of the secret template.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
 }
 
 type CertificateStatus struct {
 	GenericStatus `json:",inline"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
 }
@@ -0,0 +1,130 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DHParamSecretDHParamKey = "dhparam"
+
+	DHParamSecretDefaultType = corev1.SecretTypeOpaque
+	DHParamDefaultKey        = "dhparam.pem"
+
+	DHParamOutputSecret    = "Secret"
+	DHParamOutputConfigMap = "ConfigMap"
+
+	DHParamDefaultBits = 2048
+)
+
+var (
+	DHParamAllowedBits = []int{2048, 3072, 4096}
+)
+
+// DHParam generates Diffie-Hellman parameters (dhparam.pem)
+// in the background since generating large safe primes is slow.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Bits,JSONPath=.status.bits,description=Size of generated parameters,type=integer
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type DHParam struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DHParamSpec `json:"spec"`
+	// +optional
+	Status DHParamStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DHParamList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DHParam `json:"items"`
+}
+
+type DHParamSpec struct {
+	// Size of prime (2048, 3072 or 4096). Defaults to 2048.
+	// +optional
+	Bits int `json:"bits,omitempty"`
+
+	// Kind of object parameters are stored in (Secret or ConfigMap).
+	// Defaults to Secret.
+	// +optional
+	Output string `json:"output,omitempty"`
+
+	// Only applicable to Secret output.
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type DHParamStatus struct {
+	GenericStatus `json:",inline"`
+
+	// Size of currently stored parameters.
+	// +optional
+	Bits int `json:"bits,omitempty"`
+
+	// Time when generation of parameters started.
+	// +optional
+	GenerationStartedAt *metav1.Time `json:"generationStartedAt,omitempty"`
+	// Number of prime candidates tested so far by ongoing generation.
+	// +optional
+	CandidatesTested int64 `json:"candidatesTested,omitempty"`
+}
+
+func (p DHParam) PrimeBits() int {
+	if p.Spec.Bits == 0 {
+		return DHParamDefaultBits
+	}
+	return p.Spec.Bits
+}
+
+func (p DHParam) OutputKind() string {
+	if len(p.Spec.Output) == 0 {
+		return DHParamOutputSecret
+	}
+	return p.Spec.Output
+}
+
+func (p DHParam) Validate() error {
+	var errs []error
+
+	bitsFound := false
+	for _, bits := range DHParamAllowedBits {
+		if p.PrimeBits() == bits {
+			bitsFound = true
+		}
+	}
+	if !bitsFound {
+		errs = append(errs, fmt.Errorf("Validating 'spec.bits': Expected to be one of %v, but was %d", DHParamAllowedBits, p.Spec.Bits))
+	}
+
+	switch p.OutputKind() {
+	case DHParamOutputSecret:
+	case DHParamOutputConfigMap:
+		if p.Spec.SecretTemplate != nil {
+			errs = append(errs, fmt.Errorf("Validating 'spec.secretTemplate': Expected to be only specified for Secret output"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("Validating 'spec.output': Expected to be one of Secret or ConfigMap, but was '%s'", p.Spec.Output))
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
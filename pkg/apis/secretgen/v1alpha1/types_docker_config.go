@@ -0,0 +1,107 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DockerConfigSecretDockerConfigJSONKey = "dockerConfigJson"
+	DockerConfigSecretRegistryKey         = "registry"
+	DockerConfigSecretUsernameKey         = "username"
+	DockerConfigSecretPasswordKey         = "password"
+	DockerConfigSecretAuthKey             = "auth"
+
+	DockerConfigSecretDefaultType = corev1.SecretTypeDockerConfigJson
+	DockerConfigSecretDefaultKey  = corev1.DockerConfigJsonKey
+)
+
+// DockerConfig produces kubernetes.io/dockerconfigjson Secret
+// with registry credentials (e.g. password generated by Password).
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Registry,JSONPath=.spec.registry,description=Registry host,type=string
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type DockerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec DockerConfigSpec `json:"spec"`
+	// +optional
+	Status DockerConfigStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type DockerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []DockerConfig `json:"items"`
+}
+
+type DockerConfigSpec struct {
+	// Registry host (e.g. registry.example.com or index.docker.io).
+	Registry string `json:"registry"`
+	Username string `json:"username"`
+
+	PasswordRef DockerConfigPasswordRef `json:"passwordRef"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type DockerConfigPasswordRef struct {
+	// Name of Password or Secret holding password.
+	Name string `json:"name"`
+	// Key within Secret holding password. Defaults to password.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+type DockerConfigStatus struct {
+	GenericStatus `json:",inline"`
+}
+
+// SecretKey returns key within Secret holding password.
+func (r DockerConfigPasswordRef) SecretKey() string {
+	if len(r.Key) == 0 {
+		return PasswordSecretDefaultKey
+	}
+	return r.Key
+}
+
+func (c DockerConfig) Validate() error {
+	var errs []error
+
+	if len(c.Spec.Registry) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.registry': Expected to be non-empty"))
+	}
+
+	if len(c.Spec.Username) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.username': Expected to be non-empty"))
+	} else if strings.Contains(c.Spec.Username, ":") {
+		errs = append(errs, fmt.Errorf("Validating 'spec.username': Expected to not contain ':'"))
+	}
+
+	if len(c.Spec.PasswordRef.Name) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.passwordRef.name': Expected to be non-empty"))
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
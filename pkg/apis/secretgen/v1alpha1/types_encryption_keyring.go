@@ -0,0 +1,189 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	EncryptionKeyringSecretKeyringKey                 = "keyring"
+	EncryptionKeyringSecretPrimaryKeyKey              = "primaryKey"
+	EncryptionKeyringSecretPrimaryKeyNameKey          = "primaryKeyName"
+	EncryptionKeyringSecretAlgorithmKey               = "algorithm"
+	EncryptionKeyringSecretEncryptionConfigurationKey = "encryptionConfiguration"
+
+	EncryptionKeyringSecretDefaultType                       = corev1.SecretTypeOpaque
+	EncryptionKeyringSecretDefaultKeyringKey                 = "keyring.json"
+	EncryptionKeyringSecretDefaultEncryptionConfigurationKey = "encryption-config.yaml"
+
+	EncryptionKeyringKeysSecretSuffix = "-keys"
+
+	EncryptionKeyringAlgorithmAES256           = "AES-256"
+	EncryptionKeyringAlgorithmXSalsa20Poly1305 = "XSalsa20-Poly1305"
+
+	EncryptionKeyringProviderAESCBC    = "aescbc"
+	EncryptionKeyringProviderAESGCM    = "aesgcm"
+	EncryptionKeyringProviderSecretbox = "secretbox"
+
+	EncryptionKeyringDefaultAlgorithm        = EncryptionKeyringAlgorithmAES256
+	EncryptionKeyringDefaultRotationInterval = "90d"
+	EncryptionKeyringDefaultMaxKeys          = 3
+	EncryptionKeyringMaxMaxKeys              = 20
+)
+
+var (
+	EncryptionKeyringDefaultResources = []string{"secrets"}
+)
+
+// EncryptionKeyring periodically generates symmetric encryption keys
+// keeping previous keys around so that existing data could still be decrypted.
+// Keyring is placed into a Secret as JSON and as EncryptionConfiguration.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Primary Key,JSONPath=.status.primaryKeyName,description=Primary key name,type=string
+// +kubebuilder:printcolumn:name=Keys,JSONPath=.status.keys,description=Number of keys in keyring,type=integer
+// +kubebuilder:printcolumn:name=Next Rotation,JSONPath=.status.nextRotationTime,description=Time of next rotation,type=date
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type EncryptionKeyring struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec EncryptionKeyringSpec `json:"spec"`
+	// +optional
+	Status EncryptionKeyringStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type EncryptionKeyringList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []EncryptionKeyring `json:"items"`
+}
+
+type EncryptionKeyringSpec struct {
+	// Encryption algorithm keys are meant for (AES-256 or XSalsa20-Poly1305).
+	// Defaults to AES-256. Keys are always 32 random bytes.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// EncryptionConfiguration provider keys are listed under
+	// (aescbc or aesgcm for AES-256, secretbox for XSalsa20-Poly1305).
+	// Defaults to aescbc for AES-256 and secretbox for XSalsa20-Poly1305.
+	// +optional
+	Provider string `json:"provider,omitempty"`
+
+	// How long each key is used as primary (e.g. "2160h" or "90d"). Defaults to 90d.
+	// +optional
+	RotationInterval string `json:"rotationInterval,omitempty"`
+	// Number of keys (including primary) kept in keyring. Defaults to 3.
+	// +optional
+	MaxKeys int `json:"maxKeys,omitempty"`
+
+	// Resources listed in EncryptionConfiguration. Defaults to [secrets].
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type EncryptionKeyringStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	PrimaryKeyName string `json:"primaryKeyName,omitempty"`
+	// +optional
+	NextKeyName string `json:"nextKeyName,omitempty"`
+	// +optional
+	Keys int `json:"keys,omitempty"`
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+}
+
+// KeysSecretName returns name of the Secret holding all keys
+// used internally to carry out rotation.
+func (s EncryptionKeyring) KeysSecretName() string {
+	return s.Name + EncryptionKeyringKeysSecretSuffix
+}
+
+func (s EncryptionKeyring) KeyAlgorithm() string {
+	if len(s.Spec.Algorithm) == 0 {
+		return EncryptionKeyringDefaultAlgorithm
+	}
+	return s.Spec.Algorithm
+}
+
+func (s EncryptionKeyring) KeyProvider() string {
+	if len(s.Spec.Provider) > 0 {
+		return s.Spec.Provider
+	}
+	if s.KeyAlgorithm() == EncryptionKeyringAlgorithmXSalsa20Poly1305 {
+		return EncryptionKeyringProviderSecretbox
+	}
+	return EncryptionKeyringProviderAESCBC
+}
+
+func (s EncryptionKeyring) KeyMaxKeys() int {
+	if s.Spec.MaxKeys == 0 {
+		return EncryptionKeyringDefaultMaxKeys
+	}
+	return s.Spec.MaxKeys
+}
+
+func (s EncryptionKeyring) KeyResources() []string {
+	if len(s.Spec.Resources) == 0 {
+		return EncryptionKeyringDefaultResources
+	}
+	return s.Spec.Resources
+}
+
+func (s EncryptionKeyring) Validate() error {
+	var errs []error
+
+	switch s.KeyAlgorithm() {
+	case EncryptionKeyringAlgorithmAES256:
+		switch s.KeyProvider() {
+		case EncryptionKeyringProviderAESCBC, EncryptionKeyringProviderAESGCM:
+		default:
+			errs = append(errs, fmt.Errorf("Validating 'spec.provider': Expected to be one of aescbc or aesgcm for AES-256, but was '%s'", s.Spec.Provider))
+		}
+
+	case EncryptionKeyringAlgorithmXSalsa20Poly1305:
+		if s.KeyProvider() != EncryptionKeyringProviderSecretbox {
+			errs = append(errs, fmt.Errorf("Validating 'spec.provider': Expected to be secretbox for XSalsa20-Poly1305, but was '%s'", s.Spec.Provider))
+		}
+
+	default:
+		errs = append(errs, fmt.Errorf("Validating 'spec.algorithm': Expected to be one of AES-256 or XSalsa20-Poly1305, but was '%s'", s.Spec.Algorithm))
+	}
+
+	if s.KeyMaxKeys() < 1 || s.KeyMaxKeys() > EncryptionKeyringMaxMaxKeys {
+		errs = append(errs, fmt.Errorf("Validating 'spec.maxKeys': Expected to be between 1 and %d, but was %d",
+			EncryptionKeyringMaxMaxKeys, s.Spec.MaxKeys))
+	}
+
+	for i, res := range s.Spec.Resources {
+		if len(res) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.resources[%d]': Expected to be non-empty", i))
+		}
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
@@ -0,0 +1,159 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	HtpasswdSecretAuthKey = "auth"
+
+	HtpasswdSecretDefaultType    = corev1.SecretTypeOpaque
+	HtpasswdSecretDefaultAuthKey = "auth"
+
+	HtpasswdAlgorithmBcrypt = "bcrypt"
+	HtpasswdAlgorithmApr1   = "apr1"
+	HtpasswdAlgorithmSHA    = "sha"
+
+	HtpasswdDefaultAlgorithm  = HtpasswdAlgorithmBcrypt
+	HtpasswdDefaultBcryptCost = 10
+	HtpasswdMinBcryptCost     = 4
+	HtpasswdMaxBcryptCost     = 17
+)
+
+// Htpasswd produces htpasswd file with users
+// whose passwords are generated by Passwords.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Users,JSONPath=.status.users,description=Number of users,type=integer
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type Htpasswd struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec HtpasswdSpec `json:"spec"`
+	// +optional
+	Status HtpasswdStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type HtpasswdList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Htpasswd `json:"items"`
+}
+
+type HtpasswdSpec struct {
+	Users []HtpasswdUser `json:"users"`
+
+	// Hashing algorithm (bcrypt, apr1 or sha). Defaults to bcrypt.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// Cost of bcrypt hashing (4-17). Defaults to 10.
+	// +optional
+	BcryptCost int `json:"bcryptCost,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type HtpasswdUser struct {
+	Username    string              `json:"username"`
+	PasswordRef HtpasswdPasswordRef `json:"passwordRef"`
+}
+
+type HtpasswdPasswordRef struct {
+	// Name of Password; its Secret is expected to have the same name.
+	Name string `json:"name"`
+	// Key within Secret holding password. Defaults to password.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+type HtpasswdStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	Users int `json:"users,omitempty"`
+}
+
+// SecretKey returns key within Password Secret holding password.
+func (r HtpasswdPasswordRef) SecretKey() string {
+	if len(r.Key) == 0 {
+		return PasswordSecretDefaultKey
+	}
+	return r.Key
+}
+
+func (h Htpasswd) HashAlgorithm() string {
+	if len(h.Spec.Algorithm) == 0 {
+		return HtpasswdDefaultAlgorithm
+	}
+	return h.Spec.Algorithm
+}
+
+func (h Htpasswd) HashBcryptCost() int {
+	if h.Spec.BcryptCost == 0 {
+		return HtpasswdDefaultBcryptCost
+	}
+	return h.Spec.BcryptCost
+}
+
+func (h Htpasswd) Validate() error {
+	var errs []error
+
+	if len(h.Spec.Users) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.users': Expected to be non-empty"))
+	}
+
+	usernames := map[string]struct{}{}
+
+	for i, user := range h.Spec.Users {
+		if len(user.Username) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.users[%d].username': Expected to be non-empty", i))
+		} else if strings.ContainsAny(user.Username, ":\n\r") {
+			errs = append(errs, fmt.Errorf("Validating 'spec.users[%d].username': Expected to not contain ':' or newlines", i))
+		} else if _, found := usernames[user.Username]; found {
+			errs = append(errs, fmt.Errorf("Validating 'spec.users[%d].username': Expected to be unique, but '%s' is repeated", i, user.Username))
+		}
+		usernames[user.Username] = struct{}{}
+
+		if len(user.PasswordRef.Name) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.users[%d].passwordRef.name': Expected to be non-empty", i))
+		}
+	}
+
+	switch h.HashAlgorithm() {
+	case HtpasswdAlgorithmBcrypt, HtpasswdAlgorithmApr1, HtpasswdAlgorithmSHA:
+	default:
+		errs = append(errs, fmt.Errorf("Validating 'spec.algorithm': Expected to be one of bcrypt, apr1 or sha, but was '%s'", h.Spec.Algorithm))
+	}
+
+	if h.Spec.BcryptCost != 0 {
+		if h.HashAlgorithm() != HtpasswdAlgorithmBcrypt {
+			errs = append(errs, fmt.Errorf("Validating 'spec.bcryptCost': Expected to be only specified for bcrypt algorithm"))
+		} else if h.Spec.BcryptCost < HtpasswdMinBcryptCost || h.Spec.BcryptCost > HtpasswdMaxBcryptCost {
+			errs = append(errs, fmt.Errorf("Validating 'spec.bcryptCost': Expected to be between %d and %d, but was %d",
+				HtpasswdMinBcryptCost, HtpasswdMaxBcryptCost, h.Spec.BcryptCost))
+		}
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
@@ -0,0 +1,143 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	JSONWebKeySetSecretPrivateKeyKey      = "privateKey"
+	JSONWebKeySetSecretPrivateKeyPKCS8Key = "privateKeyPkcs8"
+	JSONWebKeySetSecretPublicKeyKey       = "publicKey"
+	JSONWebKeySetSecretJWKKey             = "jwk"
+	JSONWebKeySetSecretPublicJWKKey       = "publicJwk"
+	JSONWebKeySetSecretKeyIDKey           = "kid"
+	JSONWebKeySetSecretAlgorithmKey       = "algorithm"
+
+	JSONWebKeySetSecretDefaultType          = corev1.SecretTypeOpaque
+	JSONWebKeySetSecretDefaultPrivateKeyKey = "key.pem"
+	JSONWebKeySetSecretDefaultJWKKey        = "jwk.json"
+	JSONWebKeySetSecretDefaultKeyIDKey      = "kid"
+
+	JSONWebKeySetConfigMapJWKSKey = "jwks.json"
+
+	JSONWebKeySetKeysSecretSuffix = "-keys"
+
+	JSONWebKeySetDefaultAlgorithm        = "RS256"
+	JSONWebKeySetDefaultRotationInterval = "30d"
+	JSONWebKeySetDefaultOverlap          = "1d"
+)
+
+var (
+	JSONWebKeySetAllowedAlgorithms = []string{"RS256", "RS384", "RS512", "ES256", "ES384"}
+)
+
+// JSONWebKeySet periodically generates signing keys. Current signing key
+// is placed into a Secret, while public keys (previous, current and next)
+// are published as a JSON Web Key Set into a ConfigMap.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Current Key,JSONPath=.status.currentKeyID,description=Current key ID,type=string
+// +kubebuilder:printcolumn:name=Next Rotation,JSONPath=.status.nextRotationTime,description=Time of next rotation,type=date
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type JSONWebKeySet struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec JSONWebKeySetSpec `json:"spec"`
+	// +optional
+	Status JSONWebKeySetStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type JSONWebKeySetList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []JSONWebKeySet `json:"items"`
+}
+
+type JSONWebKeySetSpec struct {
+	// Signing algorithm (RS256, RS384, RS512, ES256 or ES384). Defaults to RS256.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// Size of generated RSA keys (2048, 3072 or 4096). Defaults to 2048.
+	// +optional
+	Bits int `json:"bits,omitempty"`
+
+	// How long each key is used for signing (e.g. "720h" or "30d"). Defaults to 30d.
+	// +optional
+	RotationInterval string `json:"rotationInterval,omitempty"`
+	// How long previous key stays published after rotation. Defaults to 1d.
+	// +optional
+	Overlap string `json:"overlap,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type JSONWebKeySetStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	CurrentKeyID string `json:"currentKeyID,omitempty"`
+	// +optional
+	NextKeyID string `json:"nextKeyID,omitempty"`
+	// +optional
+	PreviousKeyID string `json:"previousKeyID,omitempty"`
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+}
+
+// KeysSecretName returns name of the Secret holding all private keys
+// (current and next) used internally to carry out rotation.
+func (s JSONWebKeySet) KeysSecretName() string { return s.Name + JSONWebKeySetKeysSecretSuffix }
+
+func (s JSONWebKeySet) KeyAlgorithm() string {
+	if len(s.Spec.Algorithm) == 0 {
+		return JSONWebKeySetDefaultAlgorithm
+	}
+	return s.Spec.Algorithm
+}
+
+func (s JSONWebKeySet) Validate() error {
+	var errs []error
+
+	algFound := false
+	for _, alg := range JSONWebKeySetAllowedAlgorithms {
+		if s.KeyAlgorithm() == alg {
+			algFound = true
+		}
+	}
+	if !algFound {
+		errs = append(errs, fmt.Errorf("Validating 'spec.algorithm': Expected to be one of %v, but was '%s'",
+			JSONWebKeySetAllowedAlgorithms, s.Spec.Algorithm))
+	}
+
+	if s.Spec.Bits != 0 {
+		if !strings.HasPrefix(s.KeyAlgorithm(), "RS") {
+			errs = append(errs, fmt.Errorf("Validating 'spec.bits': Expected to be only specified for RSA algorithms"))
+		} else if (RSAKey{Spec: RSAKeySpec{Bits: s.Spec.Bits}}).Validate() != nil {
+			errs = append(errs, fmt.Errorf("Validating 'spec.bits': Expected to be one of %v, but was %d",
+				RSAKeyAllowedBits, s.Spec.Bits))
+		}
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
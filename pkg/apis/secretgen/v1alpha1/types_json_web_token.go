@@ -0,0 +1,194 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	JSONWebTokenSecretTokenKey = "token"
+
+	JSONWebTokenSecretDefaultType     = corev1.SecretTypeOpaque
+	JSONWebTokenSecretDefaultTokenKey = "token"
+
+	JSONWebTokenSigningKeyKindPassword = "Password"
+	JSONWebTokenSigningKeyKindRSAKey   = "RSAKey"
+	JSONWebTokenSigningKeyKindKeyPair  = "KeyPair"
+)
+
+var (
+	JSONWebTokenAllowedAlgorithms = []string{"HS256", "HS384", "HS512",
+		"RS256", "RS384", "RS512", "ES256", "ES384", "EdDSA"}
+
+	// JSONWebTokenReservedClaims are set by controller itself
+	JSONWebTokenReservedClaims = []string{"iat", "exp"}
+)
+
+// JSONWebToken mints a JWT signed by a key produced
+// by another generator (Password, RSAKey or KeyPair).
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Expires At,JSONPath=.status.expiresAt,description=Expiration time of token,type=date
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type JSONWebToken struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec JSONWebTokenSpec `json:"spec"`
+	// +optional
+	Status JSONWebTokenStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type JSONWebTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []JSONWebToken `json:"items"`
+}
+
+type JSONWebTokenSpec struct {
+	SigningKeyRef JSONWebTokenSigningKeyRef `json:"signingKeyRef"`
+
+	// Signing algorithm (HS256, HS384, HS512, RS256, RS384, RS512,
+	// ES256, ES384 or EdDSA). Defaults based on signing key.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// Claims included into token (e.g. iss, sub, aud, role).
+	// Values may be any JSON values (strings, numbers, booleans,
+	// arrays or objects).
+	// +optional
+	Claims map[string]apiextensionsv1.JSON `json:"claims,omitempty"`
+	// How long token is valid for (e.g. "24h" or "365d").
+	// Token does not expire when not specified.
+	// +optional
+	ExpiresIn string `json:"expiresIn,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type JSONWebTokenSigningKeyRef struct {
+	// Kind of generator producing signing key (Password, RSAKey or KeyPair).
+	Kind string `json:"kind"`
+	// Name of generator; its Secret is expected to have the same name.
+	Name string `json:"name"`
+	// Key within Secret holding signing key.
+	// Defaults to generator's default key (password or key.pem).
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+type JSONWebTokenStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+	// +optional
+	IssuedAt *metav1.Time `json:"issuedAt,omitempty"`
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// SigningKeySecretKey returns key within signing key Secret
+// that holds HMAC secret or PEM encoded private key.
+func (t JSONWebToken) SigningKeySecretKey() string {
+	if len(t.Spec.SigningKeyRef.Key) > 0 {
+		return t.Spec.SigningKeyRef.Key
+	}
+	switch t.Spec.SigningKeyRef.Kind {
+	case JSONWebTokenSigningKeyKindPassword:
+		return PasswordSecretDefaultKey
+	case JSONWebTokenSigningKeyKindRSAKey:
+		return RSAKeySecretDefaultPrivateKeyKey
+	default:
+		return KeyPairSecretDefaultPrivateKeyKey
+	}
+}
+
+func (t JSONWebToken) Validate() error {
+	var errs []error
+
+	switch t.Spec.SigningKeyRef.Kind {
+	case JSONWebTokenSigningKeyKindPassword, JSONWebTokenSigningKeyKindRSAKey, JSONWebTokenSigningKeyKindKeyPair:
+	default:
+		errs = append(errs, fmt.Errorf("Validating 'spec.signingKeyRef.kind': Expected to be one of Password, RSAKey or KeyPair, but was '%s'",
+			t.Spec.SigningKeyRef.Kind))
+	}
+
+	if len(t.Spec.SigningKeyRef.Name) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.signingKeyRef.name': Expected to be non-empty"))
+	}
+
+	if len(t.Spec.Algorithm) > 0 {
+		algFound := false
+		for _, alg := range JSONWebTokenAllowedAlgorithms {
+			if t.Spec.Algorithm == alg {
+				algFound = true
+			}
+		}
+		if !algFound {
+			errs = append(errs, fmt.Errorf("Validating 'spec.algorithm': Expected to be one of %v, but was '%s'",
+				JSONWebTokenAllowedAlgorithms, t.Spec.Algorithm))
+		}
+	}
+
+	for _, claim := range JSONWebTokenReservedClaims {
+		if _, found := t.Spec.Claims[claim]; found {
+			errs = append(errs, fmt.Errorf("Validating 'spec.claims.%s': Expected to not be specified as it's set by controller", claim))
+		}
+	}
+
+	_, err := t.ClaimValues()
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
+
+// ClaimValues returns decoded claims. Numbers are kept as json.Number
+// so that they are included into token exactly as specified.
+func (t JSONWebToken) ClaimValues() (map[string]interface{}, error) {
+	var names []string
+	for name := range t.Spec.Claims {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	values := map[string]interface{}{}
+
+	for _, name := range names {
+		decoder := json.NewDecoder(bytes.NewReader(t.Spec.Claims[name].Raw))
+		decoder.UseNumber()
+
+		var val interface{}
+
+		err := decoder.Decode(&val)
+		if err != nil {
+			return nil, fmt.Errorf("Validating 'spec.claims.%s': Expected to be JSON value: %s", name, err)
+		}
+		values[name] = val
+	}
+
+	return values, nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+func TestJSONWebTokenClaimValues(t *testing.T) {
+	token := sgv1alpha1.JSONWebToken{
+		Spec: sgv1alpha1.JSONWebTokenSpec{
+			SigningKeyRef: sgv1alpha1.JSONWebTokenSigningKeyRef{Kind: "Password", Name: "key"},
+			Claims: map[string]apiextensionsv1.JSON{
+				"role":  {Raw: []byte(`"anon"`)},
+				"level": {Raw: []byte(`9007199254740993`)},
+				"admin": {Raw: []byte(`true`)},
+				"aud":   {Raw: []byte(`["billing","audit"]`)},
+			},
+		},
+	}
+
+	require.NoError(t, token.Validate())
+
+	claims, err := token.ClaimValues()
+	require.NoError(t, err)
+
+	claimsBs, err := json.Marshal(claims)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"role":"anon","level":9007199254740993,"admin":true,"aud":["billing","audit"]}`, string(claimsBs))
+	require.Contains(t, string(claimsBs), `"level":9007199254740993`)
+
+	t.Run("rejects reserved and malformed claims", func(t *testing.T) {
+		token.Spec.Claims["exp"] = apiextensionsv1.JSON{Raw: []byte(`1`)}
+		token.Spec.Claims["sub"] = apiextensionsv1.JSON{Raw: []byte(`{`)}
+
+		err := token.Validate()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Validating 'spec.claims.exp'")
+		require.Contains(t, err.Error(), "Validating 'spec.claims.sub': Expected to be JSON value")
+	})
+}
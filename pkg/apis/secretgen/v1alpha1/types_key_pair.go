@@ -0,0 +1,123 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	KeyPairSecretPublicKeyKey       = "publicKey"
+	KeyPairSecretPrivateKeyKey      = "privateKey"
+	KeyPairSecretPrivateKeyPKCS8Key = "privateKeyPkcs8"
+	KeyPairSecretJWKKey             = "jwk"
+	KeyPairSecretPublicJWKKey       = "publicJwk"
+	KeyPairSecretKeyIDKey           = "kid"
+
+	KeyPairSecretDefaultType          = corev1.SecretTypeOpaque
+	KeyPairSecretDefaultPublicKeyKey  = "pub.pem"
+	KeyPairSecretDefaultPrivateKeyKey = "key.pem"
+
+	KeyPairAlgorithmECDSA   = "ecdsa"
+	KeyPairAlgorithmEd25519 = "ed25519"
+
+	KeyPairCurveP256 = "P-256"
+	KeyPairCurveP384 = "P-384"
+
+	KeyPairDefaultAlgorithm = KeyPairAlgorithmECDSA
+	KeyPairDefaultCurve     = KeyPairCurveP256
+)
+
+// KeyPair generates elliptic curve (ECDSA or Ed25519) key pair.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Algorithm,JSONPath=.spec.algorithm,description=Key algorithm,type=string
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type KeyPair struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec KeyPairSpec `json:"spec"`
+	// +optional
+	Status KeyPairStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KeyPairList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []KeyPair `json:"items"`
+}
+
+type KeyPairSpec struct {
+	// Key algorithm (ecdsa or ed25519). Defaults to ecdsa.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// Curve used for ecdsa keys (P-256 or P-384). Defaults to P-256.
+	// +optional
+	Curve string `json:"curve,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type KeyPairStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+}
+
+func (k KeyPair) KeyAlgorithm() string {
+	if len(k.Spec.Algorithm) == 0 {
+		return KeyPairDefaultAlgorithm
+	}
+	return k.Spec.Algorithm
+}
+
+// KeyCurve returns curve for ecdsa keys; empty for other algorithms.
+func (k KeyPair) KeyCurve() string {
+	if k.KeyAlgorithm() != KeyPairAlgorithmECDSA {
+		return ""
+	}
+	if len(k.Spec.Curve) == 0 {
+		return KeyPairDefaultCurve
+	}
+	return k.Spec.Curve
+}
+
+func (k KeyPair) Validate() error {
+	var errs []error
+
+	switch k.KeyAlgorithm() {
+	case KeyPairAlgorithmECDSA:
+		if k.KeyCurve() != KeyPairCurveP256 && k.KeyCurve() != KeyPairCurveP384 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.curve': Expected to be one of P-256 or P-384, but was '%s'", k.Spec.Curve))
+		}
+
+	case KeyPairAlgorithmEd25519:
+		if len(k.Spec.Curve) > 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.curve': Expected to not be specified for algorithm 'ed25519'"))
+		}
+
+	default:
+		errs = append(errs, fmt.Errorf("Validating 'spec.algorithm': Expected to be one of ecdsa or ed25519, but was '%s'", k.Spec.Algorithm))
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
@@ -0,0 +1,173 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	OpenPGPKeySecretPrivateKeyKey  = "privateKey"
+	OpenPGPKeySecretPublicKeyKey   = "publicKey"
+	OpenPGPKeySecretFingerprintKey = "fingerprint"
+	OpenPGPKeySecretKeyIDKey       = "keyID"
+
+	OpenPGPKeySecretDefaultType          = corev1.SecretTypeOpaque
+	OpenPGPKeySecretDefaultPrivateKeyKey = "private.asc"
+	OpenPGPKeySecretDefaultPublicKeyKey  = "public.asc"
+
+	OpenPGPKeyAlgorithmRSA     = "rsa"
+	OpenPGPKeyAlgorithmEd25519 = "ed25519"
+
+	OpenPGPKeyDefaultAlgorithm = OpenPGPKeyAlgorithmRSA
+	OpenPGPKeyDefaultBits      = 3072
+)
+
+var (
+	OpenPGPKeyAllowedBits = []int{2048, 3072, 4096}
+)
+
+// OpenPGPKey generates OpenPGP key (primary signing key and encryption subkey).
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Fingerprint,JSONPath=.status.fingerprint,description=Key fingerprint,type=string
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type OpenPGPKey struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec OpenPGPKeySpec `json:"spec"`
+	// +optional
+	Status OpenPGPKeyStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type OpenPGPKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []OpenPGPKey `json:"items"`
+}
+
+type OpenPGPKeySpec struct {
+	// Name included into user ID (e.g. "Release Signing").
+	Name string `json:"name"`
+	// Email included into user ID.
+	// +optional
+	Email string `json:"email,omitempty"`
+	// Comment included into user ID.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Key algorithm (rsa or ed25519). Defaults to rsa.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+	// Size of generated RSA key (2048, 3072 or 4096). Defaults to 3072.
+	// +optional
+	Bits int `json:"bits,omitempty"`
+	// How long key is valid for (e.g. "365d"). Key does not expire when not specified.
+	// Changing expiry regenerates key.
+	// +optional
+	Expiry string `json:"expiry,omitempty"`
+
+	// Password whose value is used to protect private key.
+	// Private key is not protected when not specified.
+	// +optional
+	PassphraseRef *OpenPGPKeyPassphraseRef `json:"passphraseRef,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type OpenPGPKeyPassphraseRef struct {
+	// Name of Password; its Secret is expected to have the same name.
+	Name string `json:"name"`
+	// Key within Secret holding passphrase. Defaults to password.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+type OpenPGPKeyStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+}
+
+func (k OpenPGPKey) KeyAlgorithm() string {
+	if len(k.Spec.Algorithm) == 0 {
+		return OpenPGPKeyDefaultAlgorithm
+	}
+	return k.Spec.Algorithm
+}
+
+func (k OpenPGPKey) KeyBits() int {
+	if k.Spec.Bits == 0 {
+		return OpenPGPKeyDefaultBits
+	}
+	return k.Spec.Bits
+}
+
+// PassphraseSecretKey returns key within passphrase Secret; only applicable
+// when spec.passphraseRef is set.
+func (k OpenPGPKey) PassphraseSecretKey() string {
+	if k.Spec.PassphraseRef != nil && len(k.Spec.PassphraseRef.Key) > 0 {
+		return k.Spec.PassphraseRef.Key
+	}
+	return PasswordSecretDefaultKey
+}
+
+func (k OpenPGPKey) Validate() error {
+	var errs []error
+
+	if len(k.Spec.Name) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.name': Expected to be non-empty"))
+	}
+
+	switch k.KeyAlgorithm() {
+	case OpenPGPKeyAlgorithmRSA:
+		bitsFound := false
+		for _, bits := range OpenPGPKeyAllowedBits {
+			if k.KeyBits() == bits {
+				bitsFound = true
+			}
+		}
+		if !bitsFound {
+			errs = append(errs, fmt.Errorf("Validating 'spec.bits': Expected to be one of %v, but was %d",
+				OpenPGPKeyAllowedBits, k.Spec.Bits))
+		}
+
+	case OpenPGPKeyAlgorithmEd25519:
+		if k.Spec.Bits != 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.bits': Expected to not be specified for algorithm 'ed25519'"))
+		}
+
+	default:
+		errs = append(errs, fmt.Errorf("Validating 'spec.algorithm': Expected to be one of rsa or ed25519, but was '%s'", k.Spec.Algorithm))
+	}
+
+	if k.Spec.PassphraseRef != nil && len(k.Spec.PassphraseRef.Name) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.passphraseRef.name': Expected to be non-empty"))
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
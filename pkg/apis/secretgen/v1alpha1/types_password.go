@@ -72,8 +72,29 @@ type PasswordSpec struct {
 
 	// +optional
 	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+
+	// Take ownership of already existing Secret with the same name
+	// keeping its values (e.g. when migrating to secretgen-controller).
+	// Secret is expected to contain all keys of the secret template.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
+
+	// How long values replaced during rotation are kept available
+	// as previous.* variables (e.g. "1h" or "1d"). By default
+	// previous values are not kept.
+	// +optional
+	RotationGracePeriod string `json:"rotationGracePeriod,omitempty"`
 }
 
 type PasswordStatus struct {
 	GenericStatus `json:",inline"`
+
+	// +optional
+	LastSecretDrift *SecretDriftStatus `json:"lastSecretDrift,omitempty"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+	// Time when values replaced during last rotation stop being available
+	// +optional
+	PreviousValuesExpirationTimestamp *metav1.Time `json:"previousValuesExpirationTimestamp,omitempty"`
 }
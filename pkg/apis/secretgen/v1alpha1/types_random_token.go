@@ -0,0 +1,134 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	RandomTokenSecretValueKey     = "value"
+	RandomTokenSecretHexKey       = "hex"
+	RandomTokenSecretBase64Key    = "base64"
+	RandomTokenSecretBase64URLKey = "base64url"
+	RandomTokenSecretBase32Key    = "base32"
+
+	RandomTokenSecretDefaultType     = corev1.SecretTypeOpaque
+	RandomTokenSecretDefaultValueKey = "token"
+
+	RandomTokenEncodingHex       = "hex"
+	RandomTokenEncodingBase64    = "base64"
+	RandomTokenEncodingBase64URL = "base64url"
+	RandomTokenEncodingBase32    = "base32"
+	RandomTokenEncodingUUID      = "uuid"
+
+	RandomTokenDefaultEncoding = RandomTokenEncodingHex
+	RandomTokenDefaultBytes    = 32
+	RandomTokenMaxBytes        = 1024
+	RandomTokenUUIDBytes       = 16
+)
+
+var (
+	RandomTokenAllowedEncodings = []string{RandomTokenEncodingHex, RandomTokenEncodingBase64,
+		RandomTokenEncodingBase64URL, RandomTokenEncodingBase32, RandomTokenEncodingUUID}
+)
+
+// RandomToken generates random bytes (e.g. for API tokens, HMAC keys
+// or webhook secrets) and encodes them as a string.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type RandomToken struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec RandomTokenSpec `json:"spec"`
+	// +optional
+	Status RandomTokenStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RandomTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []RandomToken `json:"items"`
+}
+
+type RandomTokenSpec struct {
+	// Number of random bytes (1 to 1024). Defaults to 32 (16 for uuid).
+	// +optional
+	Bytes int `json:"bytes,omitempty"`
+	// Encoding of value (hex, base64, base64url, base32 or uuid). Defaults to hex.
+	// +optional
+	Encoding string `json:"encoding,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type RandomTokenStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+}
+
+func (t RandomToken) TokenEncoding() string {
+	if len(t.Spec.Encoding) == 0 {
+		return RandomTokenDefaultEncoding
+	}
+	return t.Spec.Encoding
+}
+
+func (t RandomToken) TokenBytes() int {
+	switch {
+	case t.Spec.Bytes != 0:
+		return t.Spec.Bytes
+	case t.TokenEncoding() == RandomTokenEncodingUUID:
+		return RandomTokenUUIDBytes
+	default:
+		return RandomTokenDefaultBytes
+	}
+}
+
+func (t RandomToken) Validate() error {
+	var errs []error
+
+	encodingFound := false
+	for _, encoding := range RandomTokenAllowedEncodings {
+		if t.TokenEncoding() == encoding {
+			encodingFound = true
+		}
+	}
+	if !encodingFound {
+		errs = append(errs, fmt.Errorf("Validating 'spec.encoding': Expected to be one of %v, but was '%s'",
+			RandomTokenAllowedEncodings, t.Spec.Encoding))
+	}
+
+	if t.TokenEncoding() == RandomTokenEncodingUUID {
+		if t.TokenBytes() != RandomTokenUUIDBytes {
+			errs = append(errs, fmt.Errorf("Validating 'spec.bytes': Expected to be %d for encoding 'uuid', but was %d",
+				RandomTokenUUIDBytes, t.Spec.Bytes))
+		}
+	} else if t.TokenBytes() < 1 || t.TokenBytes() > RandomTokenMaxBytes {
+		errs = append(errs, fmt.Errorf("Validating 'spec.bytes': Expected to be between 1 and %d, but was %d",
+			RandomTokenMaxBytes, t.Spec.Bytes))
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
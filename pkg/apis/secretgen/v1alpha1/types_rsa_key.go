@@ -4,17 +4,30 @@
 package v1alpha1
 
 import (
+	"fmt"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
-	RSAKeySecretPublicKeyKey  = "publicKey"
-	RSAKeySecretPrivateKeyKey = "privateKey"
+	RSAKeySecretPublicKeyKey       = "publicKey"
+	RSAKeySecretPrivateKeyKey      = "privateKey"
+	RSAKeySecretPrivateKeyPKCS8Key = "privateKeyPkcs8"
+	RSAKeySecretPublicKeyPKCS1Key  = "publicKeyPkcs1"
+	RSAKeySecretJWKKey             = "jwk"
+	RSAKeySecretPublicJWKKey       = "publicJwk"
+	RSAKeySecretKeyIDKey           = "kid"
 
 	RSAKeySecretDefaultType          = corev1.SecretTypeOpaque
 	RSAKeySecretDefaultPublicKeyKey  = "pub.pem"
 	RSAKeySecretDefaultPrivateKeyKey = "key.pem"
+
+	RSAKeyDefaultBits = 2048
+)
+
+var (
+	RSAKeyAllowedBits = []int{2048, 3072, 4096}
 )
 
 // +genclient
@@ -48,10 +61,54 @@ type RSAKeyList struct {
 }
 
 type RSAKeySpec struct {
+	// Size of generated key (2048, 3072 or 4096). Defaults to 2048.
+	// +optional
+	Bits int `json:"bits,omitempty"`
+
 	// +optional
 	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+
+	// Take ownership of already existing Secret with the same name
+	// keeping its values (e.g. when migrating to secretgen-controller).
+	// Secret is expected to contain all keys of the secret template.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
+
+	// How long values replaced during rotation are kept available
+	// as previous.* variables (e.g. "1h" or "1d"). By default
+	// previous values are not kept.
+	// +optional
+	RotationGracePeriod string `json:"rotationGracePeriod,omitempty"`
 }
 
 type RSAKeyStatus struct {
 	GenericStatus `json:",inline"`
+
+	// +optional
+	LastSecretDrift *SecretDriftStatus `json:"lastSecretDrift,omitempty"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+	// Time when values replaced during last rotation stop being available
+	// +optional
+	PreviousValuesExpirationTimestamp *metav1.Time `json:"previousValuesExpirationTimestamp,omitempty"`
+}
+
+func (k RSAKey) Validate() error {
+	if k.Spec.Bits == 0 {
+		return nil
+	}
+	for _, bits := range RSAKeyAllowedBits {
+		if k.Spec.Bits == bits {
+			return nil
+		}
+	}
+	return fmt.Errorf("Expected bits to be one of %v, but was %d", RSAKeyAllowedBits, k.Spec.Bits)
+}
+
+func (k RSAKey) KeyBits() int {
+	if k.Spec.Bits == 0 {
+		return RSAKeyDefaultBits
+	}
+	return k.Spec.Bits
 }
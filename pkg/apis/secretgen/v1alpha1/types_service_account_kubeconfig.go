@@ -0,0 +1,133 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"net/url"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ServiceAccountKubeconfigSecretKubeconfigKey         = "kubeconfig"
+	ServiceAccountKubeconfigSecretTokenKey              = "token"
+	ServiceAccountKubeconfigSecretServerKey             = "server"
+	ServiceAccountKubeconfigSecretCACertKey             = "caCert"
+	ServiceAccountKubeconfigSecretNamespaceKey          = "namespace"
+	ServiceAccountKubeconfigSecretServiceAccountNameKey = "serviceAccountName"
+
+	ServiceAccountKubeconfigSecretDefaultType          = corev1.SecretTypeOpaque
+	ServiceAccountKubeconfigSecretDefaultKubeconfigKey = "kubeconfig"
+
+	// ServiceAccountKubeconfigAllowedAnnKey has to be set to "true" on
+	// ServiceAccount to allow generating kubeconfigs for it
+	ServiceAccountKubeconfigAllowedAnnKey = "secretgen.carvel.dev/allow-service-account-kubeconfig"
+
+	ServiceAccountKubeconfigDefaultExpiration  = "24h"
+	ServiceAccountKubeconfigDefaultClusterName = "kubernetes"
+)
+
+// ServiceAccountKubeconfig generates kubeconfig with a ServiceAccount token
+// obtained via TokenRequest API. Token is refreshed before it expires.
+//
+// Tokens are requested by the controller, so whoever can create this
+// resource could act as any ServiceAccount in its namespace. To keep that
+// boundary explicit, ServiceAccount has to opt in via
+// secretgen.carvel.dev/allow-service-account-kubeconfig: "true" annotation.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Service Account,JSONPath=.spec.serviceAccountName,description=Service account name,type=string
+// +kubebuilder:printcolumn:name=Expires,JSONPath=.status.expirationTimestamp,description=Token expiration time,type=date
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type ServiceAccountKubeconfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec ServiceAccountKubeconfigSpec `json:"spec"`
+	// +optional
+	Status ServiceAccountKubeconfigStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ServiceAccountKubeconfigList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []ServiceAccountKubeconfig `json:"items"`
+}
+
+type ServiceAccountKubeconfigSpec struct {
+	// Name of ServiceAccount (in the same namespace) token is requested for.
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// How long each token is valid for (e.g. "24h" or "7d"). Defaults to 24h.
+	// API server may issue tokens with shorter expiration.
+	// +optional
+	Expiration string `json:"expiration,omitempty"`
+	// Intended audiences of the token. Defaults to API server audiences.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+
+	// API server URL used in kubeconfig (e.g. external load balancer address).
+	// Defaults to API server URL used by the controller.
+	// +optional
+	Server string `json:"server,omitempty"`
+	// Name of cluster in kubeconfig. Defaults to kubernetes.
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type ServiceAccountKubeconfigStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	IssuedAt *metav1.Time `json:"issuedAt,omitempty"`
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty"`
+}
+
+func (s ServiceAccountKubeconfig) KubeconfigClusterName() string {
+	if len(s.Spec.ClusterName) == 0 {
+		return ServiceAccountKubeconfigDefaultClusterName
+	}
+	return s.Spec.ClusterName
+}
+
+func (s ServiceAccountKubeconfig) Validate() error {
+	var errs []error
+
+	if len(s.Spec.ServiceAccountName) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.serviceAccountName': Expected to be non-empty"))
+	}
+
+	if len(s.Spec.Server) > 0 {
+		serverURL, err := url.Parse(s.Spec.Server)
+		if err != nil || serverURL.Scheme != "https" || len(serverURL.Host) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.server': Expected to be https URL, but was '%s'", s.Spec.Server))
+		}
+	}
+
+	for i, aud := range s.Spec.Audiences {
+		if len(aud) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.audiences[%d]': Expected to be non-empty", i))
+		}
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
@@ -0,0 +1,177 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	SSHCertificateSecretPrivateKeyKey    = "privateKey"
+	SSHCertificateSecretAuthorizedKeyKey = "authorizedKey"
+	SSHCertificateSecretCertificateKey   = "certificate"
+	SSHCertificateSecretCAPublicKeyKey   = "caPublicKey"
+	SSHCertificateSecretCAKnownHostsKey  = "caKnownHosts"
+
+	SSHCertificateSecretDefaultType           = corev1.SecretTypeSSHAuth
+	SSHCertificateSecretDefaultPrivateKeyKey  = corev1.SSHAuthPrivateKey
+	SSHCertificateSecretDefaultCertificateKey = "ssh-certificate"
+	SSHCertificateSecretDefaultCAPublicKeyKey = "ca.pub"
+
+	SSHCertificateTypeUser = "user"
+	SSHCertificateTypeHost = "host"
+
+	SSHCertificateDefaultKeyType  = SSHKeyTypeEd25519
+	SSHCertificateDefaultValidity = "30d"
+)
+
+// SSHCertificate generates a key pair and signs it with SSHKey
+// acting as a certificate authority producing OpenSSH certificate.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Valid Before,JSONPath=.status.validBefore,description=Expiration time of certificate,type=date
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type SSHCertificate struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec SSHCertificateSpec `json:"spec"`
+	// +optional
+	Status SSHCertificateStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SSHCertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []SSHCertificate `json:"items"`
+}
+
+type SSHCertificateSpec struct {
+	// Secret produced by SSHKey that acts as certificate authority.
+	CARef corev1.LocalObjectReference `json:"caRef"`
+
+	// Certificate type (user or host). Defaults to user.
+	// +optional
+	CertType string `json:"certType,omitempty"`
+	// Key ID embedded into certificate (shows up in sshd logs).
+	// Defaults to <namespace>/<name>.
+	// +optional
+	KeyID string `json:"keyID,omitempty"`
+	// User names (user certificates) or host names (host certificates)
+	// certificate is valid for. At least one principal is required.
+	Principals []string `json:"principals"`
+
+	// Validity of certificate (e.g. "24h" or "30d"). Defaults to 30d.
+	// +optional
+	Validity string `json:"validity,omitempty"`
+	// Backdates start of validity to tolerate clock skew (e.g. "5m").
+	// +optional
+	NotBeforeSkew string `json:"notBeforeSkew,omitempty"`
+
+	// Critical options (e.g. force-command, source-address).
+	// +optional
+	CriticalOptions map[string]string `json:"criticalOptions,omitempty"`
+	// Extensions (e.g. permit-pty). User certificates get OpenSSH
+	// default extensions when not specified.
+	// +optional
+	Extensions map[string]string `json:"extensions,omitempty"`
+
+	// Type of generated key pair (rsa, ecdsa or ed25519). Defaults to ed25519.
+	// +optional
+	KeyType string `json:"keyType,omitempty"`
+	// Size of generated key pair; same values as SSHKey's bits.
+	// +optional
+	KeyBits int `json:"keyBits,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type SSHCertificateStatus struct {
+	GenericStatus `json:",inline"`
+
+	// Serial is limited to 63 bits so that it fits into integer field
+	// +optional
+	Serial int64 `json:"serial,omitempty"`
+	// +optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+	// +optional
+	ValidAfter *metav1.Time `json:"validAfter,omitempty"`
+	// +optional
+	ValidBefore *metav1.Time `json:"validBefore,omitempty"`
+}
+
+func (c SSHCertificate) CertificateType() string {
+	if len(c.Spec.CertType) == 0 {
+		return SSHCertificateTypeUser
+	}
+	return c.Spec.CertType
+}
+
+func (c SSHCertificate) KeyID() string {
+	if len(c.Spec.KeyID) == 0 {
+		return c.Namespace + "/" + c.Name
+	}
+	return c.Spec.KeyID
+}
+
+func (c SSHCertificate) KeyType() string {
+	if len(c.Spec.KeyType) == 0 {
+		return SSHCertificateDefaultKeyType
+	}
+	return c.Spec.KeyType
+}
+
+func (c SSHCertificate) KeyBits() int { return sshKeyBits(c.KeyType(), c.Spec.KeyBits) }
+
+func (c SSHCertificate) Validate() error {
+	var errs []error
+
+	if len(c.Spec.CARef.Name) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.caRef.name': Expected to be non-empty"))
+	}
+
+	if c.CertificateType() != SSHCertificateTypeUser && c.CertificateType() != SSHCertificateTypeHost {
+		errs = append(errs, fmt.Errorf("Validating 'spec.certType': Expected to be one of user or host, but was '%s'", c.Spec.CertType))
+	}
+
+	if len(c.Spec.Principals) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.principals': Expected to have at least one principal"))
+	}
+	for i, principal := range c.Spec.Principals {
+		// Host principals are joined into known_hosts line
+		if len(principal) == 0 || strings.ContainsAny(principal, " ,") || strings.IndexFunc(principal, unicode.IsControl) >= 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.principals[%d]': Expected to be non-empty and not contain spaces, commas or control characters", i))
+		}
+	}
+
+	// Key ID is used as comment of authorized key
+	if strings.IndexFunc(c.Spec.KeyID, unicode.IsControl) >= 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.keyID': Expected to not contain newlines or other control characters"))
+	}
+
+	err := validateSSHKeyTypeAndBits("spec.keyType", c.KeyType(), "spec.keyBits", c.Spec.KeyBits)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
@@ -4,6 +4,10 @@
 package v1alpha1
 
 import (
+	"fmt"
+	"strings"
+	"unicode"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -11,15 +15,38 @@ import (
 const (
 	SSHKeySecretPrivateKeyKey    = "privateKey"
 	SSHKeySecretAuthorizedKeyKey = "authorizedKey"
+	SSHKeySecretFingerprintKey   = "fingerprint"
 
 	SSHKeySecretDefaultType             = corev1.SecretTypeSSHAuth
 	SSHKeySecretDefaultPrivateKeyKey    = corev1.SSHAuthPrivateKey
 	SSHKeySecretDefaultAuthorizedKeyKey = "ssh-authorizedkey"
+
+	SSHKeyTypeRSA     = "rsa"
+	SSHKeyTypeECDSA   = "ecdsa"
+	SSHKeyTypeEd25519 = "ed25519"
+
+	SSHKeyDefaultType = SSHKeyTypeRSA
+
+	SSHKeyKnownHostsConfigMapSuffix = "-known-hosts"
+	SSHKeyKnownHostsConfigMapKey    = "known_hosts"
+
+	SSHKeyKnownHostsDefaultPort          = 22
+	SSHKeyKnownHostsDefaultClusterDomain = "cluster.local"
+)
+
+var (
+	// SSHKeyAllowedBits lists allowed sizes per key type with first being default
+	SSHKeyAllowedBits = map[string][]int{
+		SSHKeyTypeRSA:     {2048, 3072, 4096},
+		SSHKeyTypeECDSA:   {256, 384, 521},
+		SSHKeyTypeEd25519: nil,
+	}
 )
 
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Fingerprint,JSONPath=.status.fingerprint,description=Key fingerprint,type=string
 // +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
 // +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
 type SSHKey struct {
@@ -48,10 +75,210 @@ type SSHKeyList struct {
 }
 
 type SSHKeySpec struct {
+	// Key type (rsa, ecdsa or ed25519). Defaults to rsa.
+	// +optional
+	Type string `json:"type,omitempty"`
+	// Size of generated key (rsa: 2048, 3072 or 4096; ecdsa: 256, 384 or 521).
+	// Not applicable to ed25519.
+	// +optional
+	Bits int `json:"bits,omitempty"`
+	// Comment included into private key and authorized key.
+	// Must not contain newlines or other control characters.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+
+	// Publishes known_hosts entries for generated key
+	// so that it could be used as a host key.
+	// +optional
+	KnownHosts *SSHKeyKnownHosts `json:"knownHosts,omitempty"`
+
 	// +optional
 	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+
+	// Take ownership of already existing Secret with the same name
+	// keeping its values (e.g. when migrating to secretgen-controller).
+	// Secret is expected to contain all keys of the secret template.
+	// +optional
+	AdoptExisting bool `json:"adoptExisting,omitempty"`
+
+	// How long values replaced during rotation are kept available
+	// as previous.* variables (e.g. "1h" or "1d"). By default
+	// previous values are not kept.
+	// +optional
+	RotationGracePeriod string `json:"rotationGracePeriod,omitempty"`
+}
+
+type SSHKeyKnownHosts struct {
+	// Host names or IPs (optionally with port, e.g. "[git.example.com]:2222").
+	// +optional
+	Hosts []string `json:"hosts,omitempty"`
+	// Services whose in-cluster DNS names are included.
+	// +optional
+	Services []SSHKeyKnownHostsService `json:"services,omitempty"`
+	// Cluster DNS domain used for Service names. Defaults to cluster.local.
+	// +optional
+	ClusterDomain string `json:"clusterDomain,omitempty"`
+	// Name of ConfigMap holding known_hosts. Defaults to <name>-known-hosts.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+}
+
+type SSHKeyKnownHostsService struct {
+	Name string `json:"name"`
+	// Defaults to namespace of SSHKey.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Defaults to 22.
+	// +optional
+	Port int32 `json:"port,omitempty"`
 }
 
 type SSHKeyStatus struct {
 	GenericStatus `json:",inline"`
+
+	// SHA256 fingerprint of generated key (e.g. SHA256:...)
+	// +optional
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// +optional
+	LastSecretDrift *SecretDriftStatus `json:"lastSecretDrift,omitempty"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+	// Time when values replaced during last rotation stop being available
+	// +optional
+	PreviousValuesExpirationTimestamp *metav1.Time `json:"previousValuesExpirationTimestamp,omitempty"`
+}
+
+func (k SSHKey) KeyType() string {
+	if len(k.Spec.Type) == 0 {
+		return SSHKeyDefaultType
+	}
+	return k.Spec.Type
+}
+
+func (k SSHKey) KeyBits() int { return sshKeyBits(k.KeyType(), k.Spec.Bits) }
+
+// KnownHostsConfigMapName returns name of ConfigMap holding known_hosts;
+// only applicable when spec.knownHosts is set.
+func (k SSHKey) KnownHostsConfigMapName() string {
+	if k.Spec.KnownHosts != nil && len(k.Spec.KnownHosts.ConfigMapName) > 0 {
+		return k.Spec.KnownHosts.ConfigMapName
+	}
+	return k.Name + SSHKeyKnownHostsConfigMapSuffix
+}
+
+// KnownHostsPatterns returns host patterns (as used in known_hosts file)
+// for all configured hosts and services.
+func (k SSHKey) KnownHostsPatterns() []string {
+	if k.Spec.KnownHosts == nil {
+		return nil
+	}
+
+	var patterns []string
+
+	addPattern := func(host string, port int32) {
+		if port != 0 && port != SSHKeyKnownHostsDefaultPort {
+			host = fmt.Sprintf("[%s]:%d", host, port)
+		}
+		for _, pattern := range patterns {
+			if pattern == host {
+				return
+			}
+		}
+		patterns = append(patterns, host)
+	}
+
+	for _, host := range k.Spec.KnownHosts.Hosts {
+		addPattern(host, 0)
+	}
+
+	clusterDomain := k.Spec.KnownHosts.ClusterDomain
+	if len(clusterDomain) == 0 {
+		clusterDomain = SSHKeyKnownHostsDefaultClusterDomain
+	}
+
+	for _, svc := range k.Spec.KnownHosts.Services {
+		ns := svc.Namespace
+		if len(ns) == 0 {
+			ns = k.Namespace
+		}
+		// Include all names that resolve via cluster DNS search paths
+		if ns == k.Namespace {
+			addPattern(svc.Name, svc.Port)
+		}
+		addPattern(svc.Name+"."+ns, svc.Port)
+		addPattern(svc.Name+"."+ns+".svc", svc.Port)
+		addPattern(svc.Name+"."+ns+".svc."+clusterDomain, svc.Port)
+	}
+
+	return patterns
+}
+
+func (k SSHKey) Validate() error {
+	var errs []error
+
+	err := validateSSHKeyTypeAndBits("spec.type", k.Spec.Type, "spec.bits", k.Spec.Bits)
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	// Comment ends up on authorized_keys line hence it must not be
+	// able to start another line (e.g. adding an unrestricted key)
+	if strings.IndexFunc(k.Spec.Comment, unicode.IsControl) >= 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.comment': Expected to not contain newlines or other control characters"))
+	}
+
+	if k.Spec.KnownHosts != nil {
+		if len(k.Spec.KnownHosts.Hosts) == 0 && len(k.Spec.KnownHosts.Services) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.knownHosts': Expected at least one host or service"))
+		}
+		for i, host := range k.Spec.KnownHosts.Hosts {
+			if len(host) == 0 || strings.ContainsAny(host, " \t,") {
+				errs = append(errs, fmt.Errorf("Validating 'spec.knownHosts.hosts[%d]': Expected to be non-empty and not contain spaces or commas", i))
+			}
+		}
+		for i, svc := range k.Spec.KnownHosts.Services {
+			if len(svc.Name) == 0 {
+				errs = append(errs, fmt.Errorf("Validating 'spec.knownHosts.services[%d].name': Expected to be non-empty", i))
+			}
+			if svc.Port < 0 || svc.Port > 65535 {
+				errs = append(errs, fmt.Errorf("Validating 'spec.knownHosts.services[%d].port': Expected to be between 1 and 65535", i))
+			}
+		}
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
+
+func sshKeyBits(keyType string, bits int) int {
+	if bits == 0 && len(SSHKeyAllowedBits[keyType]) > 0 {
+		return SSHKeyAllowedBits[keyType][0]
+	}
+	return bits
+}
+
+func validateSSHKeyTypeAndBits(typeField, keyType, bitsField string, bits int) error {
+	if len(keyType) == 0 {
+		keyType = SSHKeyDefaultType
+	}
+
+	allowedBits, found := SSHKeyAllowedBits[keyType]
+	if !found {
+		return fmt.Errorf("Validating '%s': Expected to be one of rsa, ecdsa or ed25519, but was '%s'", typeField, keyType)
+	}
+
+	if bits == 0 {
+		return nil
+	}
+	if len(allowedBits) == 0 {
+		return fmt.Errorf("Validating '%s': Expected to not be specified for key type '%s'", bitsField, keyType)
+	}
+	for _, allowed := range allowedBits {
+		if bits == allowed {
+			return nil
+		}
+	}
+	return fmt.Errorf("Validating '%s': Expected to be one of %v for key type '%s', but was %d",
+		bitsField, allowedBits, keyType, bits)
 }
@@ -0,0 +1,24 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+)
+
+func TestSSHKeyValidateComment(t *testing.T) {
+	key := sgv1alpha1.SSHKey{Spec: sgv1alpha1.SSHKeySpec{Comment: "deploy@ci (prod)"}}
+	require.NoError(t, key.Validate())
+
+	for _, comment := range []string{"deploy\nssh-ed25519 AAAA attacker", "deploy\r", "deploy\tci", "deploy\x00", "deploy\u0085"} {
+		key := sgv1alpha1.SSHKey{Spec: sgv1alpha1.SSHKeySpec{Comment: comment}}
+
+		err := key.Validate()
+		require.Error(t, err, "Expected comment %q to be rejected", comment)
+		require.Contains(t, err.Error(), "Validating 'spec.comment'")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	TLSPairSecretCertificateKey = "certificate"
+	TLSPairSecretPrivateKeyKey  = "privateKey"
+	TLSPairSecretCAKey          = "ca"
+
+	TLSPairSecretDefaultType           = corev1.SecretTypeOpaque
+	TLSPairSecretDefaultCertificateKey = "crt.pem"
+	TLSPairSecretDefaultPrivateKeyKey  = "key.pem"
+	TLSPairSecretDefaultCAKey          = "ca.pem"
+
+	TLSPairCASecretSuffix     = "-ca"
+	TLSPairServerSecretSuffix = "-server"
+	TLSPairClientSecretInfix  = "-client-"
+)
+
+// TLSPair produces a private CA, a server certificate and a set of
+// client certificates signed by that CA, suitable for mutual TLS.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type TLSPair struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec TLSPairSpec `json:"spec"`
+	// +optional
+	Status TLSPairStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TLSPairList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TLSPair `json:"items"`
+}
+
+type TLSPairSpec struct {
+	// +optional
+	Organization string `json:"organization,omitempty"`
+	// Deprecated: use validity instead.
+	// Number of days certificate is valid for.
+	// +optional
+	Duration int64 `json:"duration,omitempty"`
+	// Validity of certificate as a duration (e.g. "2160h" or "90d").
+	// Takes precedence over duration.
+	// +optional
+	Validity string `json:"validity,omitempty"`
+	// Backdates NotBefore of certificate by given duration
+	// to tolerate clock skew between systems (e.g. "5m").
+	// +optional
+	NotBeforeSkew string `json:"notBeforeSkew,omitempty"`
+
+	// +optional
+	Server TLSPairServer `json:"server"`
+	// +optional
+	Clients []TLSPairClient `json:"clients,omitempty"`
+}
+
+type TLSPairServer struct {
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+	// +optional
+	AlternativeNames []string `json:"alternativeNames,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type TLSPairClient struct {
+	// Name identifies client and is used as a suffix of generated Secret name.
+	Name string `json:"name"`
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// Export creates SecretExport for client Secret so that
+	// it could be imported into consumer namespaces via SecretImport.
+	// +optional
+	Export *TLSPairClientExport `json:"export,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type TLSPairClientExport struct {
+	// +optional
+	ToNamespace string `json:"toNamespace,omitempty"`
+	// +optional
+	ToNamespaces []string `json:"toNamespaces,omitempty"`
+}
+
+type TLSPairStatus struct {
+	GenericStatus `json:",inline"`
+}
+
+// CASecretName returns name of the Secret holding generated CA.
+func (p TLSPair) CASecretName() string { return p.Name + TLSPairCASecretSuffix }
+
+// ServerSecretName returns name of the Secret holding server certificate.
+func (p TLSPair) ServerSecretName() string { return p.Name + TLSPairServerSecretSuffix }
+
+// ClientSecretName returns name of the Secret holding client certificate.
+func (p TLSPair) ClientSecretName(client TLSPairClient) string {
+	return p.Name + TLSPairClientSecretInfix + client.Name
+}
+
+func (p TLSPair) Validate() error {
+	var errs []error
+
+	seenClients := map[string]struct{}{}
+
+	for i, client := range p.Spec.Clients {
+		if len(client.Name) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.clients[%d].name': Expected to be non-empty", i))
+			continue
+		}
+		if _, found := seenClients[client.Name]; found {
+			errs = append(errs, fmt.Errorf("Validating 'spec.clients[%d].name': Expected to be unique, but '%s' is duplicated", i, client.Name))
+		}
+		seenClients[client.Name] = struct{}{}
+
+		if client.Export != nil && len(client.Export.ToNamespace) == 0 && len(client.Export.ToNamespaces) == 0 {
+			errs = append(errs, fmt.Errorf("Validating 'spec.clients[%d].export': Expected to have at least one non-empty to namespace", i))
+		}
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
@@ -0,0 +1,151 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	TOTPSeedSecretSeedKey      = "seed"
+	TOTPSeedSecretURIKey       = "uri"
+	TOTPSeedSecretQRCodePNGKey = "qrCodePng"
+
+	TOTPSeedSecretDefaultType         = corev1.SecretTypeOpaque
+	TOTPSeedSecretDefaultSeedKey      = "seed"
+	TOTPSeedSecretDefaultURIKey       = "uri"
+	TOTPSeedSecretDefaultQRCodePNGKey = "qr.png"
+
+	TOTPSeedAlgorithmSHA1   = "SHA1"
+	TOTPSeedAlgorithmSHA256 = "SHA256"
+	TOTPSeedAlgorithmSHA512 = "SHA512"
+
+	TOTPSeedDefaultAlgorithm = TOTPSeedAlgorithmSHA1
+	TOTPSeedDefaultDigits    = 6
+	TOTPSeedDefaultPeriod    = 30
+)
+
+// TOTPSeed generates seed for time-based one-time passwords (RFC 6238)
+// together with otpauth URI and its QR code understood by authenticator apps.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Issuer,JSONPath=.spec.issuer,description=Issuer,type=string
+// +kubebuilder:printcolumn:name=Account,JSONPath=.spec.account,description=Account name,type=string
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type TOTPSeed struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec TOTPSeedSpec `json:"spec"`
+	// +optional
+	Status TOTPSeedStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TOTPSeedList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []TOTPSeed `json:"items"`
+}
+
+type TOTPSeedSpec struct {
+	// Issuer shown by authenticator apps (e.g. company or service name).
+	Issuer string `json:"issuer"`
+	// Account name shown by authenticator apps (e.g. user's email).
+	Account string `json:"account"`
+
+	// Number of digits in generated codes (6 or 8). Defaults to 6.
+	// +optional
+	Digits int `json:"digits,omitempty"`
+	// How long each code is valid for in seconds. Defaults to 30.
+	// +optional
+	Period int `json:"period,omitempty"`
+	// HMAC algorithm (SHA1, SHA256 or SHA512). Defaults to SHA1.
+	// +optional
+	Algorithm string `json:"algorithm,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type TOTPSeedStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+}
+
+func (s TOTPSeed) SeedDigits() int {
+	if s.Spec.Digits == 0 {
+		return TOTPSeedDefaultDigits
+	}
+	return s.Spec.Digits
+}
+
+func (s TOTPSeed) SeedPeriod() int {
+	if s.Spec.Period == 0 {
+		return TOTPSeedDefaultPeriod
+	}
+	return s.Spec.Period
+}
+
+func (s TOTPSeed) SeedAlgorithm() string {
+	if len(s.Spec.Algorithm) == 0 {
+		return TOTPSeedDefaultAlgorithm
+	}
+	return s.Spec.Algorithm
+}
+
+// SeedBytes returns size of seed matching output size
+// of HMAC algorithm as recommended by RFC 6238.
+func (s TOTPSeed) SeedBytes() int {
+	switch s.SeedAlgorithm() {
+	case TOTPSeedAlgorithmSHA256:
+		return 32
+	case TOTPSeedAlgorithmSHA512:
+		return 64
+	default:
+		return 20
+	}
+}
+
+func (s TOTPSeed) Validate() error {
+	var errs []error
+
+	if len(s.Spec.Issuer) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.issuer': Expected to be non-empty"))
+	}
+	if len(s.Spec.Account) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.account': Expected to be non-empty"))
+	}
+
+	if s.SeedDigits() != 6 && s.SeedDigits() != 8 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.digits': Expected to be one of 6 or 8, but was %d", s.Spec.Digits))
+	}
+	if s.SeedPeriod() < 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.period': Expected to be positive, but was %d", s.Spec.Period))
+	}
+
+	switch s.SeedAlgorithm() {
+	case TOTPSeedAlgorithmSHA1, TOTPSeedAlgorithmSHA256, TOTPSeedAlgorithmSHA512:
+	default:
+		errs = append(errs, fmt.Errorf("Validating 'spec.algorithm': Expected to be one of SHA1, SHA256 or SHA512, but was '%s'", s.Spec.Algorithm))
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
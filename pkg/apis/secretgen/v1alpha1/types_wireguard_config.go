@@ -0,0 +1,243 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	WireGuardConfigSecretConfigKey = "config"
+
+	WireGuardConfigSecretDefaultType      = corev1.SecretTypeOpaque
+	WireGuardConfigSecretDefaultConfigKey = "wg0.conf"
+)
+
+// WireGuardConfig renders WireGuard configuration file (wg0.conf)
+// from keys produced by WireGuardKeys. Configuration is re-rendered
+// whenever referenced keys change.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Peers,JSONPath=.status.peers,description=Number of peers,type=integer
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type WireGuardConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec WireGuardConfigSpec `json:"spec"`
+	// +optional
+	Status WireGuardConfigStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WireGuardConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []WireGuardConfig `json:"items"`
+}
+
+type WireGuardConfigSpec struct {
+	Interface WireGuardConfigInterface `json:"interface"`
+	// +optional
+	Peers []WireGuardConfigPeer `json:"peers,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type WireGuardConfigInterface struct {
+	PrivateKeyRef WireGuardConfigKeyRef `json:"privateKeyRef"`
+
+	// Addresses (with prefix length) assigned to interface (e.g. 10.0.0.1/24).
+	// +optional
+	Addresses []string `json:"addresses,omitempty"`
+	// +optional
+	ListenPort int32 `json:"listenPort,omitempty"`
+	// +optional
+	DNS []string `json:"dns,omitempty"`
+	// +optional
+	MTU int32 `json:"mtu,omitempty"`
+}
+
+type WireGuardConfigPeer struct {
+	// Name of peer; included as a comment into configuration.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Base64 encoded public key of peer (e.g. for peers in other clusters).
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+	// +optional
+	PublicKeyRef *WireGuardConfigKeyRef `json:"publicKeyRef,omitempty"`
+	// +optional
+	PresharedKeyRef *WireGuardConfigKeyRef `json:"presharedKeyRef,omitempty"`
+
+	// +optional
+	AllowedIPs []string `json:"allowedIPs,omitempty"`
+	// Endpoint of peer (host:port).
+	// +optional
+	Endpoint string `json:"endpoint,omitempty"`
+	// Interval in seconds between keepalive packets.
+	// +optional
+	PersistentKeepalive int32 `json:"persistentKeepalive,omitempty"`
+}
+
+type WireGuardConfigKeyRef struct {
+	// Name of WireGuardKey; its Secret is expected to have the same name.
+	Name string `json:"name"`
+	// Key within Secret. Defaults to WireGuardKey's default key
+	// (privatekey, publickey or presharedkey).
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+type WireGuardConfigStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+	// +optional
+	Peers int `json:"peers,omitempty"`
+}
+
+// SecretKey returns key within referenced Secret or given default.
+func (r WireGuardConfigKeyRef) SecretKey(defaultKey string) string {
+	if len(r.Key) == 0 {
+		return defaultKey
+	}
+	return r.Key
+}
+
+func (c WireGuardConfig) Validate() error {
+	var errs []error
+
+	if len(c.Spec.Interface.PrivateKeyRef.Name) == 0 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.interface.privateKeyRef.name': Expected to be non-empty"))
+	}
+
+	if c.Spec.Interface.ListenPort < 0 || c.Spec.Interface.ListenPort > 65535 {
+		errs = append(errs, fmt.Errorf("Validating 'spec.interface.listenPort': Expected to be between 0 and 65535, but was %d",
+			c.Spec.Interface.ListenPort))
+	}
+
+	// Values are rendered into configuration file as is, hence they are
+	// validated strictly to prevent injecting additional settings
+	// (e.g. PostUp commands executed by wg-quick)
+	for i, addr := range c.Spec.Interface.Addresses {
+		err := validateWireGuardIPOrCIDR(addr)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("Validating 'spec.interface.addresses[%d]': %s", i, err))
+		}
+	}
+
+	for i, dns := range c.Spec.Interface.DNS {
+		if net.ParseIP(dns) == nil && !wireGuardHostnameRegexp.MatchString(dns) {
+			errs = append(errs, fmt.Errorf("Validating 'spec.interface.dns[%d]': Expected to be an IP address or a hostname, but was %q", i, dns))
+		}
+	}
+
+	for i, peer := range c.Spec.Peers {
+		path := fmt.Sprintf("spec.peers[%d]", i)
+
+		if strings.IndexFunc(peer.Name, unicode.IsControl) >= 0 {
+			errs = append(errs, fmt.Errorf("Validating '%s.name': Expected to not contain line breaks or other control characters", path))
+		}
+
+		for j, allowedIP := range peer.AllowedIPs {
+			err := validateWireGuardIPOrCIDR(allowedIP)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Validating '%s.allowedIPs[%d]': %s", path, j, err))
+			}
+		}
+
+		if len(peer.Endpoint) > 0 {
+			err := validateWireGuardEndpoint(peer.Endpoint)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Validating '%s.endpoint': %s", path, err))
+			}
+		}
+
+		switch {
+		case len(peer.PublicKey) > 0 && peer.PublicKeyRef != nil:
+			errs = append(errs, fmt.Errorf("Validating '%s': Expected only one of publicKey or publicKeyRef to be specified", path))
+		case len(peer.PublicKey) > 0:
+			err := ValidateWireGuardKey(peer.PublicKey)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("Validating '%s.publicKey': %s", path, err))
+			}
+		case peer.PublicKeyRef != nil:
+			if len(peer.PublicKeyRef.Name) == 0 {
+				errs = append(errs, fmt.Errorf("Validating '%s.publicKeyRef.name': Expected to be non-empty", path))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("Validating '%s': Expected one of publicKey or publicKeyRef to be specified", path))
+		}
+
+		if peer.PresharedKeyRef != nil && len(peer.PresharedKeyRef.Name) == 0 {
+			errs = append(errs, fmt.Errorf("Validating '%s.presharedKeyRef.name': Expected to be non-empty", path))
+		}
+		if peer.PersistentKeepalive < 0 || peer.PersistentKeepalive > 65535 {
+			errs = append(errs, fmt.Errorf("Validating '%s.persistentKeepalive': Expected to be between 0 and 65535, but was %d",
+				path, peer.PersistentKeepalive))
+		}
+	}
+
+	return combinedErrs("Validation errors", errs)
+}
+
+var wireGuardHostnameRegexp = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)*\.?$`)
+
+func validateWireGuardIPOrCIDR(val string) error {
+	if net.ParseIP(val) != nil {
+		return nil
+	}
+	if _, _, err := net.ParseCIDR(val); err == nil {
+		return nil
+	}
+	return fmt.Errorf("Expected to be an IP address or CIDR (e.g. 10.0.0.1/24), but was %q", val)
+}
+
+func validateWireGuardEndpoint(val string) error {
+	host, port, err := net.SplitHostPort(val)
+	if err != nil || len(host) == 0 {
+		return fmt.Errorf("Expected to be in host:port format, but was %q", val)
+	}
+	if net.ParseIP(host) == nil && !wireGuardHostnameRegexp.MatchString(host) {
+		return fmt.Errorf("Expected host to be an IP address or a hostname, but was %q", host)
+	}
+	portNum, err := strconv.ParseUint(port, 10, 16)
+	if err != nil || portNum == 0 {
+		return fmt.Errorf("Expected port to be between 1 and 65535, but was %q", port)
+	}
+	return nil
+}
+
+// ValidateWireGuardKey checks that key is base64 encoded 32 bytes.
+func ValidateWireGuardKey(key string) error {
+	bs, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(bs) != 32 {
+		return fmt.Errorf("Expected to be base64 encoded 32 byte key")
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+)
+
+func TestWireGuardConfigValidate(t *testing.T) {
+	validConfig := func() sgv1alpha1.WireGuardConfig {
+		return sgv1alpha1.WireGuardConfig{
+			Spec: sgv1alpha1.WireGuardConfigSpec{
+				Interface: sgv1alpha1.WireGuardConfigInterface{
+					PrivateKeyRef: sgv1alpha1.WireGuardConfigKeyRef{Name: "gateway"},
+					Addresses:     []string{"10.0.0.1/24", "fd00::1/64"},
+					DNS:           []string{"10.0.0.53", "cluster.local"},
+				},
+				Peers: []sgv1alpha1.WireGuardConfigPeer{{
+					Name:         "laptop",
+					PublicKeyRef: &sgv1alpha1.WireGuardConfigKeyRef{Name: "laptop"},
+					AllowedIPs:   []string{"10.0.0.2/32", "10.0.1.1"},
+					Endpoint:     "vpn.example.com:51820",
+				}},
+			},
+		}
+	}
+
+	t.Run("accepts valid config", func(t *testing.T) {
+		require.NoError(t, validConfig().Validate())
+
+		config := validConfig()
+		config.Spec.Peers[0].Endpoint = "[fd00::2]:51820"
+		require.NoError(t, config.Validate())
+	})
+
+	invalidCases := map[string]func(*sgv1alpha1.WireGuardConfig){
+		"address with injected setting": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Interface.Addresses = []string{"10.0.0.1/24\nPostUp = rm -rf /"}
+		},
+		"dns with injected setting": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Interface.DNS = []string{"10.0.0.53\nPostUp = id"}
+		},
+		"peer name with line break": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Peers[0].Name = "laptop\n[Interface]\nPostUp = id"
+		},
+		"peer name with carriage return": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Peers[0].Name = "laptop\rPostUp = id"
+		},
+		"allowed IP that is not CIDR": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Peers[0].AllowedIPs = []string{"0.0.0.0/0, 10.0.0.0/8"}
+		},
+		"endpoint without port": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Peers[0].Endpoint = "vpn.example.com"
+		},
+		"endpoint with injected setting": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Peers[0].Endpoint = "vpn.example.com:51820\nPostUp = id"
+		},
+		"endpoint with invalid port": func(c *sgv1alpha1.WireGuardConfig) {
+			c.Spec.Peers[0].Endpoint = "vpn.example.com:70000"
+		},
+	}
+
+	for desc, mutateFunc := range invalidCases {
+		t.Run("rejects "+desc, func(t *testing.T) {
+			config := validConfig()
+			mutateFunc(&config)
+			require.Error(t, config.Validate())
+		})
+	}
+}
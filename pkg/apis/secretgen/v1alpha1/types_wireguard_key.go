@@ -0,0 +1,73 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	WireGuardKeySecretPrivateKeyKey   = "privateKey"
+	WireGuardKeySecretPublicKeyKey    = "publicKey"
+	WireGuardKeySecretPresharedKeyKey = "presharedKey"
+
+	WireGuardKeySecretDefaultType            = corev1.SecretTypeOpaque
+	WireGuardKeySecretDefaultPrivateKeyKey   = "privatekey"
+	WireGuardKeySecretDefaultPublicKeyKey    = "publickey"
+	WireGuardKeySecretDefaultPresharedKeyKey = "presharedkey"
+)
+
+// WireGuardKey generates Curve25519 key pair (and optionally
+// preshared key) in the format used by WireGuard.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Public Key,JSONPath=.status.publicKey,description=Public key,type=string
+// +kubebuilder:printcolumn:name=Description,JSONPath=.status.friendlyDescription,description=Friendly description,type=string
+// +kubebuilder:printcolumn:name=Age,JSONPath=.metadata.creationTimestamp,description=Time since creation,type=date
+type WireGuardKey struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard object metadata; More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec WireGuardKeySpec `json:"spec"`
+	// +optional
+	Status WireGuardKeyStatus `json:"status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type WireGuardKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Standard list metadata.
+	// More info: https://git.k8s.io/community/contributors/devel/api-conventions.md#types-kinds
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []WireGuardKey `json:"items"`
+}
+
+type WireGuardKeySpec struct {
+	// Generates additional symmetric key that could be used
+	// as preshared key between two peers.
+	// +optional
+	PresharedKey bool `json:"presharedKey,omitempty"`
+
+	// +optional
+	SecretTemplate *SecretTemplate `json:"secretTemplate,omitempty"`
+}
+
+type WireGuardKeyStatus struct {
+	GenericStatus `json:",inline"`
+
+	// +optional
+	PublicKey string `json:"publicKey,omitempty"`
+
+	// +optional
+	LastRotation *RotationStatus `json:"lastRotation,omitempty"`
+}
@@ -7,9 +7,114 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeKey) DeepCopyInto(out *AgeKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeKey.
+func (in *AgeKey) DeepCopy() *AgeKey {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgeKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeKeyList) DeepCopyInto(out *AgeKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AgeKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeKeyList.
+func (in *AgeKeyList) DeepCopy() *AgeKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AgeKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeKeySpec) DeepCopyInto(out *AgeKeySpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeKeySpec.
+func (in *AgeKeySpec) DeepCopy() *AgeKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgeKeyStatus) DeepCopyInto(out *AgeKeyStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgeKeyStatus.
+func (in *AgeKeyStatus) DeepCopy() *AgeKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgeKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Certificate) DeepCopyInto(out *Certificate) {
 	*out = *in
@@ -111,6 +216,11 @@ func (in *CertificateSpec) DeepCopy() *CertificateSpec {
 func (in *CertificateStatus) DeepCopyInto(out *CertificateStatus) {
 	*out = *in
 	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -141,28 +251,7 @@ func (in *Condition) DeepCopy() *Condition {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GenericStatus) DeepCopyInto(out *GenericStatus) {
-	*out = *in
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]Condition, len(*in))
-		copy(*out, *in)
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericStatus.
-func (in *GenericStatus) DeepCopy() *GenericStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(GenericStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Password) DeepCopyInto(out *Password) {
+func (in *DHParam) DeepCopyInto(out *DHParam) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -171,18 +260,18 @@ func (in *Password) DeepCopyInto(out *Password) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Password.
-func (in *Password) DeepCopy() *Password {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHParam.
+func (in *DHParam) DeepCopy() *DHParam {
 	if in == nil {
 		return nil
 	}
-	out := new(Password)
+	out := new(DHParam)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Password) DeepCopyObject() runtime.Object {
+func (in *DHParam) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -190,13 +279,13 @@ func (in *Password) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordList) DeepCopyInto(out *PasswordList) {
+func (in *DHParamList) DeepCopyInto(out *DHParamList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Password, len(*in))
+		*out = make([]DHParam, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -204,18 +293,18 @@ func (in *PasswordList) DeepCopyInto(out *PasswordList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordList.
-func (in *PasswordList) DeepCopy() *PasswordList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHParamList.
+func (in *DHParamList) DeepCopy() *DHParamList {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordList)
+	out := new(DHParamList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PasswordList) DeepCopyObject() runtime.Object {
+func (in *DHParamList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -223,7 +312,7 @@ func (in *PasswordList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordSpec) DeepCopyInto(out *PasswordSpec) {
+func (in *DHParamSpec) DeepCopyInto(out *DHParamSpec) {
 	*out = *in
 	if in.SecretTemplate != nil {
 		in, out := &in.SecretTemplate, &out.SecretTemplate
@@ -233,35 +322,39 @@ func (in *PasswordSpec) DeepCopyInto(out *PasswordSpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordSpec.
-func (in *PasswordSpec) DeepCopy() *PasswordSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHParamSpec.
+func (in *DHParamSpec) DeepCopy() *DHParamSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordSpec)
+	out := new(DHParamSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PasswordStatus) DeepCopyInto(out *PasswordStatus) {
+func (in *DHParamStatus) DeepCopyInto(out *DHParamStatus) {
 	*out = *in
 	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.GenerationStartedAt != nil {
+		in, out := &in.GenerationStartedAt, &out.GenerationStartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordStatus.
-func (in *PasswordStatus) DeepCopy() *PasswordStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DHParamStatus.
+func (in *DHParamStatus) DeepCopy() *DHParamStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(PasswordStatus)
+	out := new(DHParamStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RSAKey) DeepCopyInto(out *RSAKey) {
+func (in *DockerConfig) DeepCopyInto(out *DockerConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -270,18 +363,18 @@ func (in *RSAKey) DeepCopyInto(out *RSAKey) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKey.
-func (in *RSAKey) DeepCopy() *RSAKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfig.
+func (in *DockerConfig) DeepCopy() *DockerConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(RSAKey)
+	out := new(DockerConfig)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RSAKey) DeepCopyObject() runtime.Object {
+func (in *DockerConfig) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -289,13 +382,13 @@ func (in *RSAKey) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RSAKeyList) DeepCopyInto(out *RSAKeyList) {
+func (in *DockerConfigList) DeepCopyInto(out *DockerConfigList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RSAKey, len(*in))
+		*out = make([]DockerConfig, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -303,18 +396,18 @@ func (in *RSAKeyList) DeepCopyInto(out *RSAKeyList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKeyList.
-func (in *RSAKeyList) DeepCopy() *RSAKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfigList.
+func (in *DockerConfigList) DeepCopy() *DockerConfigList {
 	if in == nil {
 		return nil
 	}
-	out := new(RSAKeyList)
+	out := new(DockerConfigList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RSAKeyList) DeepCopyObject() runtime.Object {
+func (in *DockerConfigList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -322,8 +415,25 @@ func (in *RSAKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RSAKeySpec) DeepCopyInto(out *RSAKeySpec) {
+func (in *DockerConfigPasswordRef) DeepCopyInto(out *DockerConfigPasswordRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfigPasswordRef.
+func (in *DockerConfigPasswordRef) DeepCopy() *DockerConfigPasswordRef {
+	if in == nil {
+		return nil
+	}
+	out := new(DockerConfigPasswordRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DockerConfigSpec) DeepCopyInto(out *DockerConfigSpec) {
 	*out = *in
+	out.PasswordRef = in.PasswordRef
 	if in.SecretTemplate != nil {
 		in, out := &in.SecretTemplate, &out.SecretTemplate
 		*out = new(SecretTemplate)
@@ -332,35 +442,35 @@ func (in *RSAKeySpec) DeepCopyInto(out *RSAKeySpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKeySpec.
-func (in *RSAKeySpec) DeepCopy() *RSAKeySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfigSpec.
+func (in *DockerConfigSpec) DeepCopy() *DockerConfigSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RSAKeySpec)
+	out := new(DockerConfigSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RSAKeyStatus) DeepCopyInto(out *RSAKeyStatus) {
+func (in *DockerConfigStatus) DeepCopyInto(out *DockerConfigStatus) {
 	*out = *in
 	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKeyStatus.
-func (in *RSAKeyStatus) DeepCopy() *RSAKeyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DockerConfigStatus.
+func (in *DockerConfigStatus) DeepCopy() *DockerConfigStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RSAKeyStatus)
+	out := new(DockerConfigStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKey) DeepCopyInto(out *SSHKey) {
+func (in *EncryptionKeyring) DeepCopyInto(out *EncryptionKeyring) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -369,18 +479,18 @@ func (in *SSHKey) DeepCopyInto(out *SSHKey) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKey.
-func (in *SSHKey) DeepCopy() *SSHKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyring.
+func (in *EncryptionKeyring) DeepCopy() *EncryptionKeyring {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKey)
+	out := new(EncryptionKeyring)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SSHKey) DeepCopyObject() runtime.Object {
+func (in *EncryptionKeyring) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -388,13 +498,13 @@ func (in *SSHKey) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKeyList) DeepCopyInto(out *SSHKeyList) {
+func (in *EncryptionKeyringList) DeepCopyInto(out *EncryptionKeyringList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]SSHKey, len(*in))
+		*out = make([]EncryptionKeyring, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -402,18 +512,18 @@ func (in *SSHKeyList) DeepCopyInto(out *SSHKeyList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyList.
-func (in *SSHKeyList) DeepCopy() *SSHKeyList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyringList.
+func (in *EncryptionKeyringList) DeepCopy() *EncryptionKeyringList {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKeyList)
+	out := new(EncryptionKeyringList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SSHKeyList) DeepCopyObject() runtime.Object {
+func (in *EncryptionKeyringList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -421,8 +531,13 @@ func (in *SSHKeyList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKeySpec) DeepCopyInto(out *SSHKeySpec) {
+func (in *EncryptionKeyringSpec) DeepCopyInto(out *EncryptionKeyringSpec) {
 	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.SecretTemplate != nil {
 		in, out := &in.SecretTemplate, &out.SecretTemplate
 		*out = new(SecretTemplate)
@@ -431,83 +546,2049 @@ func (in *SSHKeySpec) DeepCopyInto(out *SSHKeySpec) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeySpec.
-func (in *SSHKeySpec) DeepCopy() *SSHKeySpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyringSpec.
+func (in *EncryptionKeyringSpec) DeepCopy() *EncryptionKeyringSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKeySpec)
+	out := new(EncryptionKeyringSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SSHKeyStatus) DeepCopyInto(out *SSHKeyStatus) {
+func (in *EncryptionKeyringStatus) DeepCopyInto(out *EncryptionKeyringStatus) {
 	*out = *in
 	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyStatus.
-func (in *SSHKeyStatus) DeepCopy() *SSHKeyStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionKeyringStatus.
+func (in *EncryptionKeyringStatus) DeepCopy() *EncryptionKeyringStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SSHKeyStatus)
+	out := new(EncryptionKeyringStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretTemplate) DeepCopyInto(out *SecretTemplate) {
+func (in *GenericStatus) DeepCopyInto(out *GenericStatus) {
 	*out = *in
-	in.Metadata.DeepCopyInto(&out.Metadata)
-	if in.StringData != nil {
-		in, out := &in.StringData, &out.StringData
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTemplate.
-func (in *SecretTemplate) DeepCopy() *SecretTemplate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericStatus.
+func (in *GenericStatus) DeepCopy() *GenericStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretTemplate)
+	out := new(GenericStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecretTemplateMetadata) DeepCopyInto(out *SecretTemplateMetadata) {
+func (in *Htpasswd) DeepCopyInto(out *Htpasswd) {
 	*out = *in
-	if in.Annotations != nil {
-		in, out := &in.Annotations, &out.Annotations
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Htpasswd.
+func (in *Htpasswd) DeepCopy() *Htpasswd {
+	if in == nil {
+		return nil
 	}
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
+	out := new(Htpasswd)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Htpasswd) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HtpasswdList) DeepCopyInto(out *HtpasswdList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Htpasswd, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTemplateMetadata.
-func (in *SecretTemplateMetadata) DeepCopy() *SecretTemplateMetadata {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HtpasswdList.
+func (in *HtpasswdList) DeepCopy() *HtpasswdList {
 	if in == nil {
 		return nil
 	}
-	out := new(SecretTemplateMetadata)
+	out := new(HtpasswdList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HtpasswdList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HtpasswdPasswordRef) DeepCopyInto(out *HtpasswdPasswordRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HtpasswdPasswordRef.
+func (in *HtpasswdPasswordRef) DeepCopy() *HtpasswdPasswordRef {
+	if in == nil {
+		return nil
+	}
+	out := new(HtpasswdPasswordRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HtpasswdSpec) DeepCopyInto(out *HtpasswdSpec) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]HtpasswdUser, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HtpasswdSpec.
+func (in *HtpasswdSpec) DeepCopy() *HtpasswdSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HtpasswdSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HtpasswdStatus) DeepCopyInto(out *HtpasswdStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HtpasswdStatus.
+func (in *HtpasswdStatus) DeepCopy() *HtpasswdStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HtpasswdStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HtpasswdUser) DeepCopyInto(out *HtpasswdUser) {
+	*out = *in
+	out.PasswordRef = in.PasswordRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HtpasswdUser.
+func (in *HtpasswdUser) DeepCopy() *HtpasswdUser {
+	if in == nil {
+		return nil
+	}
+	out := new(HtpasswdUser)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebKeySet) DeepCopyInto(out *JSONWebKeySet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebKeySet.
+func (in *JSONWebKeySet) DeepCopy() *JSONWebKeySet {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebKeySet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONWebKeySet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebKeySetList) DeepCopyInto(out *JSONWebKeySetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JSONWebKeySet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebKeySetList.
+func (in *JSONWebKeySetList) DeepCopy() *JSONWebKeySetList {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebKeySetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONWebKeySetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebKeySetSpec) DeepCopyInto(out *JSONWebKeySetSpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebKeySetSpec.
+func (in *JSONWebKeySetSpec) DeepCopy() *JSONWebKeySetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebKeySetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebKeySetStatus) DeepCopyInto(out *JSONWebKeySetStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.NextRotationTime != nil {
+		in, out := &in.NextRotationTime, &out.NextRotationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebKeySetStatus.
+func (in *JSONWebKeySetStatus) DeepCopy() *JSONWebKeySetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebKeySetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebToken) DeepCopyInto(out *JSONWebToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebToken.
+func (in *JSONWebToken) DeepCopy() *JSONWebToken {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONWebToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebTokenList) DeepCopyInto(out *JSONWebTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JSONWebToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebTokenList.
+func (in *JSONWebTokenList) DeepCopy() *JSONWebTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JSONWebTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebTokenSigningKeyRef) DeepCopyInto(out *JSONWebTokenSigningKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebTokenSigningKeyRef.
+func (in *JSONWebTokenSigningKeyRef) DeepCopy() *JSONWebTokenSigningKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebTokenSigningKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebTokenSpec) DeepCopyInto(out *JSONWebTokenSpec) {
+	*out = *in
+	out.SigningKeyRef = in.SigningKeyRef
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make(map[string]apiextensionsv1.JSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebTokenSpec.
+func (in *JSONWebTokenSpec) DeepCopy() *JSONWebTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JSONWebTokenStatus) DeepCopyInto(out *JSONWebTokenStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.IssuedAt != nil {
+		in, out := &in.IssuedAt, &out.IssuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JSONWebTokenStatus.
+func (in *JSONWebTokenStatus) DeepCopy() *JSONWebTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(JSONWebTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPair) DeepCopyInto(out *KeyPair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPair.
+func (in *KeyPair) DeepCopy() *KeyPair {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairList) DeepCopyInto(out *KeyPairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KeyPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairList.
+func (in *KeyPairList) DeepCopy() *KeyPairList {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KeyPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairSpec) DeepCopyInto(out *KeyPairSpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairSpec.
+func (in *KeyPairSpec) DeepCopy() *KeyPairSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyPairStatus) DeepCopyInto(out *KeyPairStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairStatus.
+func (in *KeyPairStatus) DeepCopy() *KeyPairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyPairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenPGPKey) DeepCopyInto(out *OpenPGPKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenPGPKey.
+func (in *OpenPGPKey) DeepCopy() *OpenPGPKey {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenPGPKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenPGPKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenPGPKeyList) DeepCopyInto(out *OpenPGPKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenPGPKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenPGPKeyList.
+func (in *OpenPGPKeyList) DeepCopy() *OpenPGPKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenPGPKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenPGPKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenPGPKeyPassphraseRef) DeepCopyInto(out *OpenPGPKeyPassphraseRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenPGPKeyPassphraseRef.
+func (in *OpenPGPKeyPassphraseRef) DeepCopy() *OpenPGPKeyPassphraseRef {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenPGPKeyPassphraseRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenPGPKeySpec) DeepCopyInto(out *OpenPGPKeySpec) {
+	*out = *in
+	if in.PassphraseRef != nil {
+		in, out := &in.PassphraseRef, &out.PassphraseRef
+		*out = new(OpenPGPKeyPassphraseRef)
+		**out = **in
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenPGPKeySpec.
+func (in *OpenPGPKeySpec) DeepCopy() *OpenPGPKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenPGPKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenPGPKeyStatus) DeepCopyInto(out *OpenPGPKeyStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenPGPKeyStatus.
+func (in *OpenPGPKeyStatus) DeepCopy() *OpenPGPKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenPGPKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Password) DeepCopyInto(out *Password) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Password.
+func (in *Password) DeepCopy() *Password {
+	if in == nil {
+		return nil
+	}
+	out := new(Password)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Password) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordList) DeepCopyInto(out *PasswordList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Password, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordList.
+func (in *PasswordList) DeepCopy() *PasswordList {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PasswordList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordSpec) DeepCopyInto(out *PasswordSpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordSpec.
+func (in *PasswordSpec) DeepCopy() *PasswordSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PasswordStatus) DeepCopyInto(out *PasswordStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastSecretDrift != nil {
+		in, out := &in.LastSecretDrift, &out.LastSecretDrift
+		*out = new(SecretDriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousValuesExpirationTimestamp != nil {
+		in, out := &in.PreviousValuesExpirationTimestamp, &out.PreviousValuesExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PasswordStatus.
+func (in *PasswordStatus) DeepCopy() *PasswordStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PasswordStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RSAKey) DeepCopyInto(out *RSAKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKey.
+func (in *RSAKey) DeepCopy() *RSAKey {
+	if in == nil {
+		return nil
+	}
+	out := new(RSAKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RSAKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RSAKeyList) DeepCopyInto(out *RSAKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RSAKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKeyList.
+func (in *RSAKeyList) DeepCopy() *RSAKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(RSAKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RSAKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RSAKeySpec) DeepCopyInto(out *RSAKeySpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKeySpec.
+func (in *RSAKeySpec) DeepCopy() *RSAKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RSAKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RSAKeyStatus) DeepCopyInto(out *RSAKeyStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastSecretDrift != nil {
+		in, out := &in.LastSecretDrift, &out.LastSecretDrift
+		*out = new(SecretDriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousValuesExpirationTimestamp != nil {
+		in, out := &in.PreviousValuesExpirationTimestamp, &out.PreviousValuesExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RSAKeyStatus.
+func (in *RSAKeyStatus) DeepCopy() *RSAKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RSAKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomToken) DeepCopyInto(out *RandomToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomToken.
+func (in *RandomToken) DeepCopy() *RandomToken {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RandomToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomTokenList) DeepCopyInto(out *RandomTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RandomToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomTokenList.
+func (in *RandomTokenList) DeepCopy() *RandomTokenList {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomTokenList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RandomTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomTokenSpec) DeepCopyInto(out *RandomTokenSpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomTokenSpec.
+func (in *RandomTokenSpec) DeepCopy() *RandomTokenSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RandomTokenStatus) DeepCopyInto(out *RandomTokenStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RandomTokenStatus.
+func (in *RandomTokenStatus) DeepCopy() *RandomTokenStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RandomTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RotationStatus) DeepCopyInto(out *RotationStatus) {
+	*out = *in
+	in.RotatedAt.DeepCopyInto(&out.RotatedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RotationStatus.
+func (in *RotationStatus) DeepCopy() *RotationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RotationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificate) DeepCopyInto(out *SSHCertificate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificate.
+func (in *SSHCertificate) DeepCopy() *SSHCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHCertificate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateList) DeepCopyInto(out *SSHCertificateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSHCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateList.
+func (in *SSHCertificateList) DeepCopy() *SSHCertificateList {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHCertificateList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateSpec) DeepCopyInto(out *SSHCertificateSpec) {
+	*out = *in
+	out.CARef = in.CARef
+	if in.Principals != nil {
+		in, out := &in.Principals, &out.Principals
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CriticalOptions != nil {
+		in, out := &in.CriticalOptions, &out.CriticalOptions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Extensions != nil {
+		in, out := &in.Extensions, &out.Extensions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateSpec.
+func (in *SSHCertificateSpec) DeepCopy() *SSHCertificateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHCertificateStatus) DeepCopyInto(out *SSHCertificateStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.ValidAfter != nil {
+		in, out := &in.ValidAfter, &out.ValidAfter
+		*out = (*in).DeepCopy()
+	}
+	if in.ValidBefore != nil {
+		in, out := &in.ValidBefore, &out.ValidBefore
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHCertificateStatus.
+func (in *SSHCertificateStatus) DeepCopy() *SSHCertificateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHCertificateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKey) DeepCopyInto(out *SSHKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKey.
+func (in *SSHKey) DeepCopy() *SSHKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeyKnownHosts) DeepCopyInto(out *SSHKeyKnownHosts) {
+	*out = *in
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]SSHKeyKnownHostsService, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyKnownHosts.
+func (in *SSHKeyKnownHosts) DeepCopy() *SSHKeyKnownHosts {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKeyKnownHosts)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeyKnownHostsService) DeepCopyInto(out *SSHKeyKnownHostsService) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyKnownHostsService.
+func (in *SSHKeyKnownHostsService) DeepCopy() *SSHKeyKnownHostsService {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKeyKnownHostsService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeyList) DeepCopyInto(out *SSHKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSHKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyList.
+func (in *SSHKeyList) DeepCopy() *SSHKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SSHKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeySpec) DeepCopyInto(out *SSHKeySpec) {
+	*out = *in
+	if in.KnownHosts != nil {
+		in, out := &in.KnownHosts, &out.KnownHosts
+		*out = new(SSHKeyKnownHosts)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeySpec.
+func (in *SSHKeySpec) DeepCopy() *SSHKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHKeyStatus) DeepCopyInto(out *SSHKeyStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastSecretDrift != nil {
+		in, out := &in.LastSecretDrift, &out.LastSecretDrift
+		*out = new(SecretDriftStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousValuesExpirationTimestamp != nil {
+		in, out := &in.PreviousValuesExpirationTimestamp, &out.PreviousValuesExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHKeyStatus.
+func (in *SSHKeyStatus) DeepCopy() *SSHKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretDriftStatus) DeepCopyInto(out *SecretDriftStatus) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.DetectedAt.DeepCopyInto(&out.DetectedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretDriftStatus.
+func (in *SecretDriftStatus) DeepCopy() *SecretDriftStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretDriftStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretTemplate) DeepCopyInto(out *SecretTemplate) {
+	*out = *in
+	in.Metadata.DeepCopyInto(&out.Metadata)
+	if in.StringData != nil {
+		in, out := &in.StringData, &out.StringData
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTemplate.
+func (in *SecretTemplate) DeepCopy() *SecretTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretTemplateMetadata) DeepCopyInto(out *SecretTemplateMetadata) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretTemplateMetadata.
+func (in *SecretTemplateMetadata) DeepCopy() *SecretTemplateMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretTemplateMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKubeconfig) DeepCopyInto(out *ServiceAccountKubeconfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKubeconfig.
+func (in *ServiceAccountKubeconfig) DeepCopy() *ServiceAccountKubeconfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKubeconfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountKubeconfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKubeconfigList) DeepCopyInto(out *ServiceAccountKubeconfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ServiceAccountKubeconfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKubeconfigList.
+func (in *ServiceAccountKubeconfigList) DeepCopy() *ServiceAccountKubeconfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKubeconfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ServiceAccountKubeconfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKubeconfigSpec) DeepCopyInto(out *ServiceAccountKubeconfigSpec) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKubeconfigSpec.
+func (in *ServiceAccountKubeconfigSpec) DeepCopy() *ServiceAccountKubeconfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKubeconfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceAccountKubeconfigStatus) DeepCopyInto(out *ServiceAccountKubeconfigStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.IssuedAt != nil {
+		in, out := &in.IssuedAt, &out.IssuedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceAccountKubeconfigStatus.
+func (in *ServiceAccountKubeconfigStatus) DeepCopy() *ServiceAccountKubeconfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceAccountKubeconfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPair) DeepCopyInto(out *TLSPair) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPair.
+func (in *TLSPair) DeepCopy() *TLSPair {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TLSPair) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPairClient) DeepCopyInto(out *TLSPairClient) {
+	*out = *in
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(TLSPairClientExport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPairClient.
+func (in *TLSPairClient) DeepCopy() *TLSPairClient {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPairClient)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPairClientExport) DeepCopyInto(out *TLSPairClientExport) {
+	*out = *in
+	if in.ToNamespaces != nil {
+		in, out := &in.ToNamespaces, &out.ToNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPairClientExport.
+func (in *TLSPairClientExport) DeepCopy() *TLSPairClientExport {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPairClientExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPairList) DeepCopyInto(out *TLSPairList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TLSPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPairList.
+func (in *TLSPairList) DeepCopy() *TLSPairList {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPairList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TLSPairList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPairServer) DeepCopyInto(out *TLSPairServer) {
+	*out = *in
+	if in.AlternativeNames != nil {
+		in, out := &in.AlternativeNames, &out.AlternativeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPairServer.
+func (in *TLSPairServer) DeepCopy() *TLSPairServer {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPairServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPairSpec) DeepCopyInto(out *TLSPairSpec) {
+	*out = *in
+	in.Server.DeepCopyInto(&out.Server)
+	if in.Clients != nil {
+		in, out := &in.Clients, &out.Clients
+		*out = make([]TLSPairClient, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPairSpec.
+func (in *TLSPairSpec) DeepCopy() *TLSPairSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPairSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSPairStatus) DeepCopyInto(out *TLSPairStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSPairStatus.
+func (in *TLSPairStatus) DeepCopy() *TLSPairStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSPairStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPSeed) DeepCopyInto(out *TOTPSeed) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPSeed.
+func (in *TOTPSeed) DeepCopy() *TOTPSeed {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPSeed)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TOTPSeed) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPSeedList) DeepCopyInto(out *TOTPSeedList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TOTPSeed, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPSeedList.
+func (in *TOTPSeedList) DeepCopy() *TOTPSeedList {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPSeedList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TOTPSeedList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPSeedSpec) DeepCopyInto(out *TOTPSeedSpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPSeedSpec.
+func (in *TOTPSeedSpec) DeepCopy() *TOTPSeedSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPSeedSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TOTPSeedStatus) DeepCopyInto(out *TOTPSeedStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TOTPSeedStatus.
+func (in *TOTPSeedStatus) DeepCopy() *TOTPSeedStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TOTPSeedStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardConfig) DeepCopyInto(out *WireGuardConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardConfig.
+func (in *WireGuardConfig) DeepCopy() *WireGuardConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WireGuardConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardConfigInterface) DeepCopyInto(out *WireGuardConfigInterface) {
+	*out = *in
+	out.PrivateKeyRef = in.PrivateKeyRef
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DNS != nil {
+		in, out := &in.DNS, &out.DNS
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardConfigInterface.
+func (in *WireGuardConfigInterface) DeepCopy() *WireGuardConfigInterface {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardConfigInterface)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardConfigKeyRef) DeepCopyInto(out *WireGuardConfigKeyRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardConfigKeyRef.
+func (in *WireGuardConfigKeyRef) DeepCopy() *WireGuardConfigKeyRef {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardConfigKeyRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardConfigList) DeepCopyInto(out *WireGuardConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WireGuardConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardConfigList.
+func (in *WireGuardConfigList) DeepCopy() *WireGuardConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WireGuardConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardConfigPeer) DeepCopyInto(out *WireGuardConfigPeer) {
+	*out = *in
+	if in.PublicKeyRef != nil {
+		in, out := &in.PublicKeyRef, &out.PublicKeyRef
+		*out = new(WireGuardConfigKeyRef)
+		**out = **in
+	}
+	if in.PresharedKeyRef != nil {
+		in, out := &in.PresharedKeyRef, &out.PresharedKeyRef
+		*out = new(WireGuardConfigKeyRef)
+		**out = **in
+	}
+	if in.AllowedIPs != nil {
+		in, out := &in.AllowedIPs, &out.AllowedIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardConfigPeer.
+func (in *WireGuardConfigPeer) DeepCopy() *WireGuardConfigPeer {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardConfigPeer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardConfigSpec) DeepCopyInto(out *WireGuardConfigSpec) {
+	*out = *in
+	in.Interface.DeepCopyInto(&out.Interface)
+	if in.Peers != nil {
+		in, out := &in.Peers, &out.Peers
+		*out = make([]WireGuardConfigPeer, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardConfigSpec.
+func (in *WireGuardConfigSpec) DeepCopy() *WireGuardConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardConfigStatus) DeepCopyInto(out *WireGuardConfigStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardConfigStatus.
+func (in *WireGuardConfigStatus) DeepCopy() *WireGuardConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardKey) DeepCopyInto(out *WireGuardKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardKey.
+func (in *WireGuardKey) DeepCopy() *WireGuardKey {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WireGuardKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardKeyList) DeepCopyInto(out *WireGuardKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WireGuardKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardKeyList.
+func (in *WireGuardKeyList) DeepCopy() *WireGuardKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WireGuardKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardKeySpec) DeepCopyInto(out *WireGuardKeySpec) {
+	*out = *in
+	if in.SecretTemplate != nil {
+		in, out := &in.SecretTemplate, &out.SecretTemplate
+		*out = new(SecretTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardKeySpec.
+func (in *WireGuardKeySpec) DeepCopy() *WireGuardKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WireGuardKeyStatus) DeepCopyInto(out *WireGuardKeyStatus) {
+	*out = *in
+	in.GenericStatus.DeepCopyInto(&out.GenericStatus)
+	if in.LastRotation != nil {
+		in, out := &in.LastRotation, &out.LastRotation
+		*out = new(RotationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WireGuardKeyStatus.
+func (in *WireGuardKeyStatus) DeepCopy() *WireGuardKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WireGuardKeyStatus)
 	in.DeepCopyInto(out)
 	return out
 }
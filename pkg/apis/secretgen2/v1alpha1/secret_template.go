@@ -99,6 +99,10 @@ type JSONPathTemplate struct {
 	// Metadata contains metadata for the Secret
 	// +optional
 	Metadata SecretTemplateMetadata `json:"metadata,omitempty"`
+
+	// Immutable marks the Secret as immutable. Secret is deleted and created again when its data changes.
+	// +optional
+	Immutable bool `json:"immutable,omitempty"`
 }
 
 // SecretTemplateMetadata allows the generated secret to contain metadata
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AgeKeysGetter has a method to return a AgeKeyInterface.
+// A group's client should implement this interface.
+type AgeKeysGetter interface {
+	AgeKeys(namespace string) AgeKeyInterface
+}
+
+// AgeKeyInterface has methods to work with AgeKey resources.
+type AgeKeyInterface interface {
+	Create(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.CreateOptions) (*v1alpha1.AgeKey, error)
+	Update(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.UpdateOptions) (*v1alpha1.AgeKey, error)
+	UpdateStatus(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.UpdateOptions) (*v1alpha1.AgeKey, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.AgeKey, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.AgeKeyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.AgeKey, err error)
+	AgeKeyExpansion
+}
+
+// ageKeys implements AgeKeyInterface
+type ageKeys struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAgeKeys returns a AgeKeys
+func newAgeKeys(c *SecretgenV1alpha1Client, namespace string) *ageKeys {
+	return &ageKeys{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the ageKey, and returns the corresponding ageKey object, and an error if there is any.
+func (c *ageKeys) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.AgeKey, err error) {
+	result = &v1alpha1.AgeKey{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("agekeys").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AgeKeys that match those selectors.
+func (c *ageKeys) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.AgeKeyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.AgeKeyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("agekeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested ageKeys.
+func (c *ageKeys) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("agekeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a ageKey and creates it.  Returns the server's representation of the ageKey, and an error, if there is any.
+func (c *ageKeys) Create(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.CreateOptions) (result *v1alpha1.AgeKey, err error) {
+	result = &v1alpha1.AgeKey{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("agekeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(ageKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a ageKey and updates it. Returns the server's representation of the ageKey, and an error, if there is any.
+func (c *ageKeys) Update(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.UpdateOptions) (result *v1alpha1.AgeKey, err error) {
+	result = &v1alpha1.AgeKey{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("agekeys").
+		Name(ageKey.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(ageKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *ageKeys) UpdateStatus(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.UpdateOptions) (result *v1alpha1.AgeKey, err error) {
+	result = &v1alpha1.AgeKey{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("agekeys").
+		Name(ageKey.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(ageKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the ageKey and deletes it. Returns an error if one occurs.
+func (c *ageKeys) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("agekeys").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *ageKeys) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("agekeys").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched ageKey.
+func (c *ageKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.AgeKey, err error) {
+	result = &v1alpha1.AgeKey{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("agekeys").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DHParamsGetter has a method to return a DHParamInterface.
+// A group's client should implement this interface.
+type DHParamsGetter interface {
+	DHParams(namespace string) DHParamInterface
+}
+
+// DHParamInterface has methods to work with DHParam resources.
+type DHParamInterface interface {
+	Create(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.CreateOptions) (*v1alpha1.DHParam, error)
+	Update(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.UpdateOptions) (*v1alpha1.DHParam, error)
+	UpdateStatus(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.UpdateOptions) (*v1alpha1.DHParam, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.DHParam, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.DHParamList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DHParam, err error)
+	DHParamExpansion
+}
+
+// dHParams implements DHParamInterface
+type dHParams struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDHParams returns a DHParams
+func newDHParams(c *SecretgenV1alpha1Client, namespace string) *dHParams {
+	return &dHParams{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the dHParam, and returns the corresponding dHParam object, and an error if there is any.
+func (c *dHParams) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DHParam, err error) {
+	result = &v1alpha1.DHParam{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("dhparams").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of DHParams that match those selectors.
+func (c *dHParams) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DHParamList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.DHParamList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("dhparams").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested dHParams.
+func (c *dHParams) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("dhparams").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a dHParam and creates it.  Returns the server's representation of the dHParam, and an error, if there is any.
+func (c *dHParams) Create(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.CreateOptions) (result *v1alpha1.DHParam, err error) {
+	result = &v1alpha1.DHParam{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("dhparams").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(dHParam).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a dHParam and updates it. Returns the server's representation of the dHParam, and an error, if there is any.
+func (c *dHParams) Update(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.UpdateOptions) (result *v1alpha1.DHParam, err error) {
+	result = &v1alpha1.DHParam{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("dhparams").
+		Name(dHParam.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(dHParam).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *dHParams) UpdateStatus(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.UpdateOptions) (result *v1alpha1.DHParam, err error) {
+	result = &v1alpha1.DHParam{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("dhparams").
+		Name(dHParam.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(dHParam).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the dHParam and deletes it. Returns an error if one occurs.
+func (c *dHParams) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("dhparams").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *dHParams) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("dhparams").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched dHParam.
+func (c *dHParams) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DHParam, err error) {
+	result = &v1alpha1.DHParam{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("dhparams").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
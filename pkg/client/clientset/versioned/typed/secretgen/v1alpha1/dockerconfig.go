@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DockerConfigsGetter has a method to return a DockerConfigInterface.
+// A group's client should implement this interface.
+type DockerConfigsGetter interface {
+	DockerConfigs(namespace string) DockerConfigInterface
+}
+
+// DockerConfigInterface has methods to work with DockerConfig resources.
+type DockerConfigInterface interface {
+	Create(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.CreateOptions) (*v1alpha1.DockerConfig, error)
+	Update(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.UpdateOptions) (*v1alpha1.DockerConfig, error)
+	UpdateStatus(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.UpdateOptions) (*v1alpha1.DockerConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.DockerConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.DockerConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DockerConfig, err error)
+	DockerConfigExpansion
+}
+
+// dockerConfigs implements DockerConfigInterface
+type dockerConfigs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDockerConfigs returns a DockerConfigs
+func newDockerConfigs(c *SecretgenV1alpha1Client, namespace string) *dockerConfigs {
+	return &dockerConfigs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the dockerConfig, and returns the corresponding dockerConfig object, and an error if there is any.
+func (c *dockerConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DockerConfig, err error) {
+	result = &v1alpha1.DockerConfig{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of DockerConfigs that match those selectors.
+func (c *dockerConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DockerConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.DockerConfigList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested dockerConfigs.
+func (c *dockerConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a dockerConfig and creates it.  Returns the server's representation of the dockerConfig, and an error, if there is any.
+func (c *dockerConfigs) Create(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.CreateOptions) (result *v1alpha1.DockerConfig, err error) {
+	result = &v1alpha1.DockerConfig{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(dockerConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a dockerConfig and updates it. Returns the server's representation of the dockerConfig, and an error, if there is any.
+func (c *dockerConfigs) Update(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.UpdateOptions) (result *v1alpha1.DockerConfig, err error) {
+	result = &v1alpha1.DockerConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		Name(dockerConfig.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(dockerConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *dockerConfigs) UpdateStatus(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.UpdateOptions) (result *v1alpha1.DockerConfig, err error) {
+	result = &v1alpha1.DockerConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		Name(dockerConfig.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(dockerConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the dockerConfig and deletes it. Returns an error if one occurs.
+func (c *dockerConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *dockerConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched dockerConfig.
+func (c *dockerConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DockerConfig, err error) {
+	result = &v1alpha1.DockerConfig{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("dockerconfigs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
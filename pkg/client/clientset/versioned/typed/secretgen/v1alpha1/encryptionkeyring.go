@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// EncryptionKeyringsGetter has a method to return a EncryptionKeyringInterface.
+// A group's client should implement this interface.
+type EncryptionKeyringsGetter interface {
+	EncryptionKeyrings(namespace string) EncryptionKeyringInterface
+}
+
+// EncryptionKeyringInterface has methods to work with EncryptionKeyring resources.
+type EncryptionKeyringInterface interface {
+	Create(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.CreateOptions) (*v1alpha1.EncryptionKeyring, error)
+	Update(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.UpdateOptions) (*v1alpha1.EncryptionKeyring, error)
+	UpdateStatus(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.UpdateOptions) (*v1alpha1.EncryptionKeyring, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.EncryptionKeyring, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.EncryptionKeyringList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.EncryptionKeyring, err error)
+	EncryptionKeyringExpansion
+}
+
+// encryptionKeyrings implements EncryptionKeyringInterface
+type encryptionKeyrings struct {
+	client rest.Interface
+	ns     string
+}
+
+// newEncryptionKeyrings returns a EncryptionKeyrings
+func newEncryptionKeyrings(c *SecretgenV1alpha1Client, namespace string) *encryptionKeyrings {
+	return &encryptionKeyrings{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the encryptionKeyring, and returns the corresponding encryptionKeyring object, and an error if there is any.
+func (c *encryptionKeyrings) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.EncryptionKeyring, err error) {
+	result = &v1alpha1.EncryptionKeyring{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of EncryptionKeyrings that match those selectors.
+func (c *encryptionKeyrings) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.EncryptionKeyringList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.EncryptionKeyringList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested encryptionKeyrings.
+func (c *encryptionKeyrings) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a encryptionKeyring and creates it.  Returns the server's representation of the encryptionKeyring, and an error, if there is any.
+func (c *encryptionKeyrings) Create(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.CreateOptions) (result *v1alpha1.EncryptionKeyring, err error) {
+	result = &v1alpha1.EncryptionKeyring{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(encryptionKeyring).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a encryptionKeyring and updates it. Returns the server's representation of the encryptionKeyring, and an error, if there is any.
+func (c *encryptionKeyrings) Update(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.UpdateOptions) (result *v1alpha1.EncryptionKeyring, err error) {
+	result = &v1alpha1.EncryptionKeyring{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		Name(encryptionKeyring.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(encryptionKeyring).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *encryptionKeyrings) UpdateStatus(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.UpdateOptions) (result *v1alpha1.EncryptionKeyring, err error) {
+	result = &v1alpha1.EncryptionKeyring{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		Name(encryptionKeyring.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(encryptionKeyring).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the encryptionKeyring and deletes it. Returns an error if one occurs.
+func (c *encryptionKeyrings) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *encryptionKeyrings) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched encryptionKeyring.
+func (c *encryptionKeyrings) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.EncryptionKeyring, err error) {
+	result = &v1alpha1.EncryptionKeyring{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("encryptionkeyrings").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAgeKeys implements AgeKeyInterface
+type FakeAgeKeys struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var agekeysResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "agekeys"}
+
+var agekeysKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "AgeKey"}
+
+// Get takes name of the ageKey, and returns the corresponding ageKey object, and an error if there is any.
+func (c *FakeAgeKeys) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.AgeKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(agekeysResource, c.ns, name), &v1alpha1.AgeKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AgeKey), err
+}
+
+// List takes label and field selectors, and returns the list of AgeKeys that match those selectors.
+func (c *FakeAgeKeys) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.AgeKeyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(agekeysResource, agekeysKind, c.ns, opts), &v1alpha1.AgeKeyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.AgeKeyList{ListMeta: obj.(*v1alpha1.AgeKeyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.AgeKeyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested ageKeys.
+func (c *FakeAgeKeys) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(agekeysResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a ageKey and creates it.  Returns the server's representation of the ageKey, and an error, if there is any.
+func (c *FakeAgeKeys) Create(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.CreateOptions) (result *v1alpha1.AgeKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(agekeysResource, c.ns, ageKey), &v1alpha1.AgeKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AgeKey), err
+}
+
+// Update takes the representation of a ageKey and updates it. Returns the server's representation of the ageKey, and an error, if there is any.
+func (c *FakeAgeKeys) Update(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.UpdateOptions) (result *v1alpha1.AgeKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(agekeysResource, c.ns, ageKey), &v1alpha1.AgeKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AgeKey), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAgeKeys) UpdateStatus(ctx context.Context, ageKey *v1alpha1.AgeKey, opts v1.UpdateOptions) (*v1alpha1.AgeKey, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(agekeysResource, "status", c.ns, ageKey), &v1alpha1.AgeKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AgeKey), err
+}
+
+// Delete takes name of the ageKey and deletes it. Returns an error if one occurs.
+func (c *FakeAgeKeys) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(agekeysResource, c.ns, name), &v1alpha1.AgeKey{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAgeKeys) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(agekeysResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.AgeKeyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched ageKey.
+func (c *FakeAgeKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.AgeKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(agekeysResource, c.ns, name, pt, data, subresources...), &v1alpha1.AgeKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.AgeKey), err
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDHParams implements DHParamInterface
+type FakeDHParams struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var dhparamsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "dhparams"}
+
+var dhparamsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "DHParam"}
+
+// Get takes name of the dHParam, and returns the corresponding dHParam object, and an error if there is any.
+func (c *FakeDHParams) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DHParam, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(dhparamsResource, c.ns, name), &v1alpha1.DHParam{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DHParam), err
+}
+
+// List takes label and field selectors, and returns the list of DHParams that match those selectors.
+func (c *FakeDHParams) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DHParamList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(dhparamsResource, dhparamsKind, c.ns, opts), &v1alpha1.DHParamList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DHParamList{ListMeta: obj.(*v1alpha1.DHParamList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DHParamList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested dHParams.
+func (c *FakeDHParams) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(dhparamsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a dHParam and creates it.  Returns the server's representation of the dHParam, and an error, if there is any.
+func (c *FakeDHParams) Create(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.CreateOptions) (result *v1alpha1.DHParam, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(dhparamsResource, c.ns, dHParam), &v1alpha1.DHParam{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DHParam), err
+}
+
+// Update takes the representation of a dHParam and updates it. Returns the server's representation of the dHParam, and an error, if there is any.
+func (c *FakeDHParams) Update(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.UpdateOptions) (result *v1alpha1.DHParam, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(dhparamsResource, c.ns, dHParam), &v1alpha1.DHParam{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DHParam), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDHParams) UpdateStatus(ctx context.Context, dHParam *v1alpha1.DHParam, opts v1.UpdateOptions) (*v1alpha1.DHParam, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(dhparamsResource, "status", c.ns, dHParam), &v1alpha1.DHParam{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DHParam), err
+}
+
+// Delete takes name of the dHParam and deletes it. Returns an error if one occurs.
+func (c *FakeDHParams) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(dhparamsResource, c.ns, name), &v1alpha1.DHParam{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDHParams) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(dhparamsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DHParamList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched dHParam.
+func (c *FakeDHParams) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DHParam, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(dhparamsResource, c.ns, name, pt, data, subresources...), &v1alpha1.DHParam{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DHParam), err
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDockerConfigs implements DockerConfigInterface
+type FakeDockerConfigs struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var dockerconfigsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "dockerconfigs"}
+
+var dockerconfigsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "DockerConfig"}
+
+// Get takes name of the dockerConfig, and returns the corresponding dockerConfig object, and an error if there is any.
+func (c *FakeDockerConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.DockerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(dockerconfigsResource, c.ns, name), &v1alpha1.DockerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DockerConfig), err
+}
+
+// List takes label and field selectors, and returns the list of DockerConfigs that match those selectors.
+func (c *FakeDockerConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DockerConfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(dockerconfigsResource, dockerconfigsKind, c.ns, opts), &v1alpha1.DockerConfigList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DockerConfigList{ListMeta: obj.(*v1alpha1.DockerConfigList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DockerConfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested dockerConfigs.
+func (c *FakeDockerConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(dockerconfigsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a dockerConfig and creates it.  Returns the server's representation of the dockerConfig, and an error, if there is any.
+func (c *FakeDockerConfigs) Create(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.CreateOptions) (result *v1alpha1.DockerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(dockerconfigsResource, c.ns, dockerConfig), &v1alpha1.DockerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DockerConfig), err
+}
+
+// Update takes the representation of a dockerConfig and updates it. Returns the server's representation of the dockerConfig, and an error, if there is any.
+func (c *FakeDockerConfigs) Update(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.UpdateOptions) (result *v1alpha1.DockerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(dockerconfigsResource, c.ns, dockerConfig), &v1alpha1.DockerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DockerConfig), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDockerConfigs) UpdateStatus(ctx context.Context, dockerConfig *v1alpha1.DockerConfig, opts v1.UpdateOptions) (*v1alpha1.DockerConfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(dockerconfigsResource, "status", c.ns, dockerConfig), &v1alpha1.DockerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DockerConfig), err
+}
+
+// Delete takes name of the dockerConfig and deletes it. Returns an error if one occurs.
+func (c *FakeDockerConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(dockerconfigsResource, c.ns, name), &v1alpha1.DockerConfig{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDockerConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(dockerconfigsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DockerConfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched dockerConfig.
+func (c *FakeDockerConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.DockerConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(dockerconfigsResource, c.ns, name, pt, data, subresources...), &v1alpha1.DockerConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.DockerConfig), err
+}
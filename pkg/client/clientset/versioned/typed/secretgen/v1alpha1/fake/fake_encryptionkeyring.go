@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeEncryptionKeyrings implements EncryptionKeyringInterface
+type FakeEncryptionKeyrings struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var encryptionkeyringsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "encryptionkeyrings"}
+
+var encryptionkeyringsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "EncryptionKeyring"}
+
+// Get takes name of the encryptionKeyring, and returns the corresponding encryptionKeyring object, and an error if there is any.
+func (c *FakeEncryptionKeyrings) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.EncryptionKeyring, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(encryptionkeyringsResource, c.ns, name), &v1alpha1.EncryptionKeyring{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EncryptionKeyring), err
+}
+
+// List takes label and field selectors, and returns the list of EncryptionKeyrings that match those selectors.
+func (c *FakeEncryptionKeyrings) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.EncryptionKeyringList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(encryptionkeyringsResource, encryptionkeyringsKind, c.ns, opts), &v1alpha1.EncryptionKeyringList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.EncryptionKeyringList{ListMeta: obj.(*v1alpha1.EncryptionKeyringList).ListMeta}
+	for _, item := range obj.(*v1alpha1.EncryptionKeyringList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested encryptionKeyrings.
+func (c *FakeEncryptionKeyrings) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(encryptionkeyringsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a encryptionKeyring and creates it.  Returns the server's representation of the encryptionKeyring, and an error, if there is any.
+func (c *FakeEncryptionKeyrings) Create(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.CreateOptions) (result *v1alpha1.EncryptionKeyring, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(encryptionkeyringsResource, c.ns, encryptionKeyring), &v1alpha1.EncryptionKeyring{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EncryptionKeyring), err
+}
+
+// Update takes the representation of a encryptionKeyring and updates it. Returns the server's representation of the encryptionKeyring, and an error, if there is any.
+func (c *FakeEncryptionKeyrings) Update(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.UpdateOptions) (result *v1alpha1.EncryptionKeyring, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(encryptionkeyringsResource, c.ns, encryptionKeyring), &v1alpha1.EncryptionKeyring{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EncryptionKeyring), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeEncryptionKeyrings) UpdateStatus(ctx context.Context, encryptionKeyring *v1alpha1.EncryptionKeyring, opts v1.UpdateOptions) (*v1alpha1.EncryptionKeyring, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(encryptionkeyringsResource, "status", c.ns, encryptionKeyring), &v1alpha1.EncryptionKeyring{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EncryptionKeyring), err
+}
+
+// Delete takes name of the encryptionKeyring and deletes it. Returns an error if one occurs.
+func (c *FakeEncryptionKeyrings) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(encryptionkeyringsResource, c.ns, name), &v1alpha1.EncryptionKeyring{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeEncryptionKeyrings) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(encryptionkeyringsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.EncryptionKeyringList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched encryptionKeyring.
+func (c *FakeEncryptionKeyrings) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.EncryptionKeyring, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(encryptionkeyringsResource, c.ns, name, pt, data, subresources...), &v1alpha1.EncryptionKeyring{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EncryptionKeyring), err
+}
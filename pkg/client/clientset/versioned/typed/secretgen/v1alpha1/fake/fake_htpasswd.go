@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeHtpasswds implements HtpasswdInterface
+type FakeHtpasswds struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var htpasswdsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "htpasswds"}
+
+var htpasswdsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "Htpasswd"}
+
+// Get takes name of the htpasswd, and returns the corresponding htpasswd object, and an error if there is any.
+func (c *FakeHtpasswds) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Htpasswd, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(htpasswdsResource, c.ns, name), &v1alpha1.Htpasswd{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Htpasswd), err
+}
+
+// List takes label and field selectors, and returns the list of Htpasswds that match those selectors.
+func (c *FakeHtpasswds) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.HtpasswdList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(htpasswdsResource, htpasswdsKind, c.ns, opts), &v1alpha1.HtpasswdList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.HtpasswdList{ListMeta: obj.(*v1alpha1.HtpasswdList).ListMeta}
+	for _, item := range obj.(*v1alpha1.HtpasswdList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested htpasswds.
+func (c *FakeHtpasswds) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(htpasswdsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a htpasswd and creates it.  Returns the server's representation of the htpasswd, and an error, if there is any.
+func (c *FakeHtpasswds) Create(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.CreateOptions) (result *v1alpha1.Htpasswd, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(htpasswdsResource, c.ns, htpasswd), &v1alpha1.Htpasswd{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Htpasswd), err
+}
+
+// Update takes the representation of a htpasswd and updates it. Returns the server's representation of the htpasswd, and an error, if there is any.
+func (c *FakeHtpasswds) Update(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.UpdateOptions) (result *v1alpha1.Htpasswd, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(htpasswdsResource, c.ns, htpasswd), &v1alpha1.Htpasswd{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Htpasswd), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeHtpasswds) UpdateStatus(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.UpdateOptions) (*v1alpha1.Htpasswd, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(htpasswdsResource, "status", c.ns, htpasswd), &v1alpha1.Htpasswd{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Htpasswd), err
+}
+
+// Delete takes name of the htpasswd and deletes it. Returns an error if one occurs.
+func (c *FakeHtpasswds) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(htpasswdsResource, c.ns, name), &v1alpha1.Htpasswd{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeHtpasswds) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(htpasswdsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.HtpasswdList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched htpasswd.
+func (c *FakeHtpasswds) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Htpasswd, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(htpasswdsResource, c.ns, name, pt, data, subresources...), &v1alpha1.Htpasswd{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Htpasswd), err
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeJSONWebKeySets implements JSONWebKeySetInterface
+type FakeJSONWebKeySets struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var jsonwebkeysetsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "jsonwebkeysets"}
+
+var jsonwebkeysetsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "JSONWebKeySet"}
+
+// Get takes name of the jSONWebKeySet, and returns the corresponding jSONWebKeySet object, and an error if there is any.
+func (c *FakeJSONWebKeySets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.JSONWebKeySet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(jsonwebkeysetsResource, c.ns, name), &v1alpha1.JSONWebKeySet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebKeySet), err
+}
+
+// List takes label and field selectors, and returns the list of JSONWebKeySets that match those selectors.
+func (c *FakeJSONWebKeySets) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.JSONWebKeySetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(jsonwebkeysetsResource, jsonwebkeysetsKind, c.ns, opts), &v1alpha1.JSONWebKeySetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.JSONWebKeySetList{ListMeta: obj.(*v1alpha1.JSONWebKeySetList).ListMeta}
+	for _, item := range obj.(*v1alpha1.JSONWebKeySetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested jSONWebKeySets.
+func (c *FakeJSONWebKeySets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(jsonwebkeysetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a jSONWebKeySet and creates it.  Returns the server's representation of the jSONWebKeySet, and an error, if there is any.
+func (c *FakeJSONWebKeySets) Create(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.CreateOptions) (result *v1alpha1.JSONWebKeySet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(jsonwebkeysetsResource, c.ns, jSONWebKeySet), &v1alpha1.JSONWebKeySet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebKeySet), err
+}
+
+// Update takes the representation of a jSONWebKeySet and updates it. Returns the server's representation of the jSONWebKeySet, and an error, if there is any.
+func (c *FakeJSONWebKeySets) Update(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.UpdateOptions) (result *v1alpha1.JSONWebKeySet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(jsonwebkeysetsResource, c.ns, jSONWebKeySet), &v1alpha1.JSONWebKeySet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebKeySet), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeJSONWebKeySets) UpdateStatus(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.UpdateOptions) (*v1alpha1.JSONWebKeySet, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(jsonwebkeysetsResource, "status", c.ns, jSONWebKeySet), &v1alpha1.JSONWebKeySet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebKeySet), err
+}
+
+// Delete takes name of the jSONWebKeySet and deletes it. Returns an error if one occurs.
+func (c *FakeJSONWebKeySets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(jsonwebkeysetsResource, c.ns, name), &v1alpha1.JSONWebKeySet{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeJSONWebKeySets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(jsonwebkeysetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.JSONWebKeySetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched jSONWebKeySet.
+func (c *FakeJSONWebKeySets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONWebKeySet, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(jsonwebkeysetsResource, c.ns, name, pt, data, subresources...), &v1alpha1.JSONWebKeySet{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebKeySet), err
+}
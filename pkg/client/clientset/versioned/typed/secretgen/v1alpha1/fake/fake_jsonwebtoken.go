@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeJSONWebTokens implements JSONWebTokenInterface
+type FakeJSONWebTokens struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var jsonwebtokensResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "jsonwebtokens"}
+
+var jsonwebtokensKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "JSONWebToken"}
+
+// Get takes name of the jSONWebToken, and returns the corresponding jSONWebToken object, and an error if there is any.
+func (c *FakeJSONWebTokens) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.JSONWebToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(jsonwebtokensResource, c.ns, name), &v1alpha1.JSONWebToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebToken), err
+}
+
+// List takes label and field selectors, and returns the list of JSONWebTokens that match those selectors.
+func (c *FakeJSONWebTokens) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.JSONWebTokenList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(jsonwebtokensResource, jsonwebtokensKind, c.ns, opts), &v1alpha1.JSONWebTokenList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.JSONWebTokenList{ListMeta: obj.(*v1alpha1.JSONWebTokenList).ListMeta}
+	for _, item := range obj.(*v1alpha1.JSONWebTokenList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested jSONWebTokens.
+func (c *FakeJSONWebTokens) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(jsonwebtokensResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a jSONWebToken and creates it.  Returns the server's representation of the jSONWebToken, and an error, if there is any.
+func (c *FakeJSONWebTokens) Create(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.CreateOptions) (result *v1alpha1.JSONWebToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(jsonwebtokensResource, c.ns, jSONWebToken), &v1alpha1.JSONWebToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebToken), err
+}
+
+// Update takes the representation of a jSONWebToken and updates it. Returns the server's representation of the jSONWebToken, and an error, if there is any.
+func (c *FakeJSONWebTokens) Update(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.UpdateOptions) (result *v1alpha1.JSONWebToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(jsonwebtokensResource, c.ns, jSONWebToken), &v1alpha1.JSONWebToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebToken), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeJSONWebTokens) UpdateStatus(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.UpdateOptions) (*v1alpha1.JSONWebToken, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(jsonwebtokensResource, "status", c.ns, jSONWebToken), &v1alpha1.JSONWebToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebToken), err
+}
+
+// Delete takes name of the jSONWebToken and deletes it. Returns an error if one occurs.
+func (c *FakeJSONWebTokens) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(jsonwebtokensResource, c.ns, name), &v1alpha1.JSONWebToken{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeJSONWebTokens) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(jsonwebtokensResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.JSONWebTokenList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched jSONWebToken.
+func (c *FakeJSONWebTokens) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONWebToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(jsonwebtokensResource, c.ns, name, pt, data, subresources...), &v1alpha1.JSONWebToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.JSONWebToken), err
+}
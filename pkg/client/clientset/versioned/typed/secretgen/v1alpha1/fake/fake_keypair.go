@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeKeyPairs implements KeyPairInterface
+type FakeKeyPairs struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var keypairsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "keypairs"}
+
+var keypairsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "KeyPair"}
+
+// Get takes name of the keyPair, and returns the corresponding keyPair object, and an error if there is any.
+func (c *FakeKeyPairs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KeyPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(keypairsResource, c.ns, name), &v1alpha1.KeyPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KeyPair), err
+}
+
+// List takes label and field selectors, and returns the list of KeyPairs that match those selectors.
+func (c *FakeKeyPairs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KeyPairList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(keypairsResource, keypairsKind, c.ns, opts), &v1alpha1.KeyPairList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.KeyPairList{ListMeta: obj.(*v1alpha1.KeyPairList).ListMeta}
+	for _, item := range obj.(*v1alpha1.KeyPairList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested keyPairs.
+func (c *FakeKeyPairs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(keypairsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a keyPair and creates it.  Returns the server's representation of the keyPair, and an error, if there is any.
+func (c *FakeKeyPairs) Create(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.CreateOptions) (result *v1alpha1.KeyPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(keypairsResource, c.ns, keyPair), &v1alpha1.KeyPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KeyPair), err
+}
+
+// Update takes the representation of a keyPair and updates it. Returns the server's representation of the keyPair, and an error, if there is any.
+func (c *FakeKeyPairs) Update(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.UpdateOptions) (result *v1alpha1.KeyPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(keypairsResource, c.ns, keyPair), &v1alpha1.KeyPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KeyPair), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeKeyPairs) UpdateStatus(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.UpdateOptions) (*v1alpha1.KeyPair, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(keypairsResource, "status", c.ns, keyPair), &v1alpha1.KeyPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KeyPair), err
+}
+
+// Delete takes name of the keyPair and deletes it. Returns an error if one occurs.
+func (c *FakeKeyPairs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(keypairsResource, c.ns, name), &v1alpha1.KeyPair{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeKeyPairs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(keypairsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.KeyPairList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched keyPair.
+func (c *FakeKeyPairs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KeyPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(keypairsResource, c.ns, name, pt, data, subresources...), &v1alpha1.KeyPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.KeyPair), err
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeOpenPGPKeys implements OpenPGPKeyInterface
+type FakeOpenPGPKeys struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var openpgpkeysResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "openpgpkeys"}
+
+var openpgpkeysKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "OpenPGPKey"}
+
+// Get takes name of the openPGPKey, and returns the corresponding openPGPKey object, and an error if there is any.
+func (c *FakeOpenPGPKeys) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.OpenPGPKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(openpgpkeysResource, c.ns, name), &v1alpha1.OpenPGPKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OpenPGPKey), err
+}
+
+// List takes label and field selectors, and returns the list of OpenPGPKeys that match those selectors.
+func (c *FakeOpenPGPKeys) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.OpenPGPKeyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(openpgpkeysResource, openpgpkeysKind, c.ns, opts), &v1alpha1.OpenPGPKeyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.OpenPGPKeyList{ListMeta: obj.(*v1alpha1.OpenPGPKeyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.OpenPGPKeyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested openPGPKeys.
+func (c *FakeOpenPGPKeys) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(openpgpkeysResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a openPGPKey and creates it.  Returns the server's representation of the openPGPKey, and an error, if there is any.
+func (c *FakeOpenPGPKeys) Create(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.CreateOptions) (result *v1alpha1.OpenPGPKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(openpgpkeysResource, c.ns, openPGPKey), &v1alpha1.OpenPGPKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OpenPGPKey), err
+}
+
+// Update takes the representation of a openPGPKey and updates it. Returns the server's representation of the openPGPKey, and an error, if there is any.
+func (c *FakeOpenPGPKeys) Update(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.UpdateOptions) (result *v1alpha1.OpenPGPKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(openpgpkeysResource, c.ns, openPGPKey), &v1alpha1.OpenPGPKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OpenPGPKey), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeOpenPGPKeys) UpdateStatus(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.UpdateOptions) (*v1alpha1.OpenPGPKey, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(openpgpkeysResource, "status", c.ns, openPGPKey), &v1alpha1.OpenPGPKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OpenPGPKey), err
+}
+
+// Delete takes name of the openPGPKey and deletes it. Returns an error if one occurs.
+func (c *FakeOpenPGPKeys) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(openpgpkeysResource, c.ns, name), &v1alpha1.OpenPGPKey{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeOpenPGPKeys) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(openpgpkeysResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.OpenPGPKeyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched openPGPKey.
+func (c *FakeOpenPGPKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.OpenPGPKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(openpgpkeysResource, c.ns, name, pt, data, subresources...), &v1alpha1.OpenPGPKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.OpenPGPKey), err
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeRandomTokens implements RandomTokenInterface
+type FakeRandomTokens struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var randomtokensResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "randomtokens"}
+
+var randomtokensKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "RandomToken"}
+
+// Get takes name of the randomToken, and returns the corresponding randomToken object, and an error if there is any.
+func (c *FakeRandomTokens) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RandomToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(randomtokensResource, c.ns, name), &v1alpha1.RandomToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RandomToken), err
+}
+
+// List takes label and field selectors, and returns the list of RandomTokens that match those selectors.
+func (c *FakeRandomTokens) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RandomTokenList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(randomtokensResource, randomtokensKind, c.ns, opts), &v1alpha1.RandomTokenList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.RandomTokenList{ListMeta: obj.(*v1alpha1.RandomTokenList).ListMeta}
+	for _, item := range obj.(*v1alpha1.RandomTokenList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested randomTokens.
+func (c *FakeRandomTokens) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(randomtokensResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a randomToken and creates it.  Returns the server's representation of the randomToken, and an error, if there is any.
+func (c *FakeRandomTokens) Create(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.CreateOptions) (result *v1alpha1.RandomToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(randomtokensResource, c.ns, randomToken), &v1alpha1.RandomToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RandomToken), err
+}
+
+// Update takes the representation of a randomToken and updates it. Returns the server's representation of the randomToken, and an error, if there is any.
+func (c *FakeRandomTokens) Update(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.UpdateOptions) (result *v1alpha1.RandomToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(randomtokensResource, c.ns, randomToken), &v1alpha1.RandomToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RandomToken), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeRandomTokens) UpdateStatus(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.UpdateOptions) (*v1alpha1.RandomToken, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(randomtokensResource, "status", c.ns, randomToken), &v1alpha1.RandomToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RandomToken), err
+}
+
+// Delete takes name of the randomToken and deletes it. Returns an error if one occurs.
+func (c *FakeRandomTokens) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(randomtokensResource, c.ns, name), &v1alpha1.RandomToken{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeRandomTokens) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(randomtokensResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.RandomTokenList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched randomToken.
+func (c *FakeRandomTokens) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RandomToken, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(randomtokensResource, c.ns, name, pt, data, subresources...), &v1alpha1.RandomToken{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.RandomToken), err
+}
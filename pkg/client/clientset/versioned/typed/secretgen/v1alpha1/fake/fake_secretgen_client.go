@@ -12,10 +12,46 @@ type FakeSecretgenV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeSecretgenV1alpha1) AgeKeys(namespace string) v1alpha1.AgeKeyInterface {
+	return &FakeAgeKeys{c, namespace}
+}
+
 func (c *FakeSecretgenV1alpha1) Certificates(namespace string) v1alpha1.CertificateInterface {
 	return &FakeCertificates{c, namespace}
 }
 
+func (c *FakeSecretgenV1alpha1) DHParams(namespace string) v1alpha1.DHParamInterface {
+	return &FakeDHParams{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) DockerConfigs(namespace string) v1alpha1.DockerConfigInterface {
+	return &FakeDockerConfigs{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) EncryptionKeyrings(namespace string) v1alpha1.EncryptionKeyringInterface {
+	return &FakeEncryptionKeyrings{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) Htpasswds(namespace string) v1alpha1.HtpasswdInterface {
+	return &FakeHtpasswds{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) JSONWebKeySets(namespace string) v1alpha1.JSONWebKeySetInterface {
+	return &FakeJSONWebKeySets{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) JSONWebTokens(namespace string) v1alpha1.JSONWebTokenInterface {
+	return &FakeJSONWebTokens{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) KeyPairs(namespace string) v1alpha1.KeyPairInterface {
+	return &FakeKeyPairs{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) OpenPGPKeys(namespace string) v1alpha1.OpenPGPKeyInterface {
+	return &FakeOpenPGPKeys{c, namespace}
+}
+
 func (c *FakeSecretgenV1alpha1) Passwords(namespace string) v1alpha1.PasswordInterface {
 	return &FakePasswords{c, namespace}
 }
@@ -24,10 +60,38 @@ func (c *FakeSecretgenV1alpha1) RSAKeys(namespace string) v1alpha1.RSAKeyInterfa
 	return &FakeRSAKeys{c, namespace}
 }
 
+func (c *FakeSecretgenV1alpha1) RandomTokens(namespace string) v1alpha1.RandomTokenInterface {
+	return &FakeRandomTokens{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) SSHCertificates(namespace string) v1alpha1.SSHCertificateInterface {
+	return &FakeSSHCertificates{c, namespace}
+}
+
 func (c *FakeSecretgenV1alpha1) SSHKeys(namespace string) v1alpha1.SSHKeyInterface {
 	return &FakeSSHKeys{c, namespace}
 }
 
+func (c *FakeSecretgenV1alpha1) ServiceAccountKubeconfigs(namespace string) v1alpha1.ServiceAccountKubeconfigInterface {
+	return &FakeServiceAccountKubeconfigs{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) TLSPairs(namespace string) v1alpha1.TLSPairInterface {
+	return &FakeTLSPairs{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) TOTPSeeds(namespace string) v1alpha1.TOTPSeedInterface {
+	return &FakeTOTPSeeds{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) WireGuardConfigs(namespace string) v1alpha1.WireGuardConfigInterface {
+	return &FakeWireGuardConfigs{c, namespace}
+}
+
+func (c *FakeSecretgenV1alpha1) WireGuardKeys(namespace string) v1alpha1.WireGuardKeyInterface {
+	return &FakeWireGuardKeys{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeSecretgenV1alpha1) RESTClient() rest.Interface {
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeServiceAccountKubeconfigs implements ServiceAccountKubeconfigInterface
+type FakeServiceAccountKubeconfigs struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var serviceaccountkubeconfigsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "serviceaccountkubeconfigs"}
+
+var serviceaccountkubeconfigsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "ServiceAccountKubeconfig"}
+
+// Get takes name of the serviceAccountKubeconfig, and returns the corresponding serviceAccountKubeconfig object, and an error if there is any.
+func (c *FakeServiceAccountKubeconfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(serviceaccountkubeconfigsResource, c.ns, name), &v1alpha1.ServiceAccountKubeconfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAccountKubeconfig), err
+}
+
+// List takes label and field selectors, and returns the list of ServiceAccountKubeconfigs that match those selectors.
+func (c *FakeServiceAccountKubeconfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ServiceAccountKubeconfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(serviceaccountkubeconfigsResource, serviceaccountkubeconfigsKind, c.ns, opts), &v1alpha1.ServiceAccountKubeconfigList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.ServiceAccountKubeconfigList{ListMeta: obj.(*v1alpha1.ServiceAccountKubeconfigList).ListMeta}
+	for _, item := range obj.(*v1alpha1.ServiceAccountKubeconfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested serviceAccountKubeconfigs.
+func (c *FakeServiceAccountKubeconfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(serviceaccountkubeconfigsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a serviceAccountKubeconfig and creates it.  Returns the server's representation of the serviceAccountKubeconfig, and an error, if there is any.
+func (c *FakeServiceAccountKubeconfigs) Create(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.CreateOptions) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(serviceaccountkubeconfigsResource, c.ns, serviceAccountKubeconfig), &v1alpha1.ServiceAccountKubeconfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAccountKubeconfig), err
+}
+
+// Update takes the representation of a serviceAccountKubeconfig and updates it. Returns the server's representation of the serviceAccountKubeconfig, and an error, if there is any.
+func (c *FakeServiceAccountKubeconfigs) Update(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.UpdateOptions) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(serviceaccountkubeconfigsResource, c.ns, serviceAccountKubeconfig), &v1alpha1.ServiceAccountKubeconfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAccountKubeconfig), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeServiceAccountKubeconfigs) UpdateStatus(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.UpdateOptions) (*v1alpha1.ServiceAccountKubeconfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(serviceaccountkubeconfigsResource, "status", c.ns, serviceAccountKubeconfig), &v1alpha1.ServiceAccountKubeconfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAccountKubeconfig), err
+}
+
+// Delete takes name of the serviceAccountKubeconfig and deletes it. Returns an error if one occurs.
+func (c *FakeServiceAccountKubeconfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(serviceaccountkubeconfigsResource, c.ns, name), &v1alpha1.ServiceAccountKubeconfig{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeServiceAccountKubeconfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(serviceaccountkubeconfigsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.ServiceAccountKubeconfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched serviceAccountKubeconfig.
+func (c *FakeServiceAccountKubeconfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(serviceaccountkubeconfigsResource, c.ns, name, pt, data, subresources...), &v1alpha1.ServiceAccountKubeconfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.ServiceAccountKubeconfig), err
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSSHCertificates implements SSHCertificateInterface
+type FakeSSHCertificates struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var sshcertificatesResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "sshcertificates"}
+
+var sshcertificatesKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "SSHCertificate"}
+
+// Get takes name of the sSHCertificate, and returns the corresponding sSHCertificate object, and an error if there is any.
+func (c *FakeSSHCertificates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.SSHCertificate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(sshcertificatesResource, c.ns, name), &v1alpha1.SSHCertificate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SSHCertificate), err
+}
+
+// List takes label and field selectors, and returns the list of SSHCertificates that match those selectors.
+func (c *FakeSSHCertificates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.SSHCertificateList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(sshcertificatesResource, sshcertificatesKind, c.ns, opts), &v1alpha1.SSHCertificateList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.SSHCertificateList{ListMeta: obj.(*v1alpha1.SSHCertificateList).ListMeta}
+	for _, item := range obj.(*v1alpha1.SSHCertificateList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested sSHCertificates.
+func (c *FakeSSHCertificates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(sshcertificatesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a sSHCertificate and creates it.  Returns the server's representation of the sSHCertificate, and an error, if there is any.
+func (c *FakeSSHCertificates) Create(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.CreateOptions) (result *v1alpha1.SSHCertificate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(sshcertificatesResource, c.ns, sSHCertificate), &v1alpha1.SSHCertificate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SSHCertificate), err
+}
+
+// Update takes the representation of a sSHCertificate and updates it. Returns the server's representation of the sSHCertificate, and an error, if there is any.
+func (c *FakeSSHCertificates) Update(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.UpdateOptions) (result *v1alpha1.SSHCertificate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(sshcertificatesResource, c.ns, sSHCertificate), &v1alpha1.SSHCertificate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SSHCertificate), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSSHCertificates) UpdateStatus(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.UpdateOptions) (*v1alpha1.SSHCertificate, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(sshcertificatesResource, "status", c.ns, sSHCertificate), &v1alpha1.SSHCertificate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SSHCertificate), err
+}
+
+// Delete takes name of the sSHCertificate and deletes it. Returns an error if one occurs.
+func (c *FakeSSHCertificates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(sshcertificatesResource, c.ns, name), &v1alpha1.SSHCertificate{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSSHCertificates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(sshcertificatesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.SSHCertificateList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched sSHCertificate.
+func (c *FakeSSHCertificates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.SSHCertificate, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(sshcertificatesResource, c.ns, name, pt, data, subresources...), &v1alpha1.SSHCertificate{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SSHCertificate), err
+}
@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTLSPairs implements TLSPairInterface
+type FakeTLSPairs struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var tlspairsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "tlspairs"}
+
+var tlspairsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "TLSPair"}
+
+// Get takes name of the tLSPair, and returns the corresponding tLSPair object, and an error if there is any.
+func (c *FakeTLSPairs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.TLSPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(tlspairsResource, c.ns, name), &v1alpha1.TLSPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TLSPair), err
+}
+
+// List takes label and field selectors, and returns the list of TLSPairs that match those selectors.
+func (c *FakeTLSPairs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TLSPairList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(tlspairsResource, tlspairsKind, c.ns, opts), &v1alpha1.TLSPairList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.TLSPairList{ListMeta: obj.(*v1alpha1.TLSPairList).ListMeta}
+	for _, item := range obj.(*v1alpha1.TLSPairList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested tLSPairs.
+func (c *FakeTLSPairs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(tlspairsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a tLSPair and creates it.  Returns the server's representation of the tLSPair, and an error, if there is any.
+func (c *FakeTLSPairs) Create(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.CreateOptions) (result *v1alpha1.TLSPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(tlspairsResource, c.ns, tLSPair), &v1alpha1.TLSPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TLSPair), err
+}
+
+// Update takes the representation of a tLSPair and updates it. Returns the server's representation of the tLSPair, and an error, if there is any.
+func (c *FakeTLSPairs) Update(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.UpdateOptions) (result *v1alpha1.TLSPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(tlspairsResource, c.ns, tLSPair), &v1alpha1.TLSPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TLSPair), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTLSPairs) UpdateStatus(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.UpdateOptions) (*v1alpha1.TLSPair, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(tlspairsResource, "status", c.ns, tLSPair), &v1alpha1.TLSPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TLSPair), err
+}
+
+// Delete takes name of the tLSPair and deletes it. Returns an error if one occurs.
+func (c *FakeTLSPairs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(tlspairsResource, c.ns, name), &v1alpha1.TLSPair{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTLSPairs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(tlspairsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.TLSPairList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched tLSPair.
+func (c *FakeTLSPairs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TLSPair, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(tlspairsResource, c.ns, name, pt, data, subresources...), &v1alpha1.TLSPair{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.TLSPair), err
+}
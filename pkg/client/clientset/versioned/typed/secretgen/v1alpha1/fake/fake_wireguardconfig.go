@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWireGuardConfigs implements WireGuardConfigInterface
+type FakeWireGuardConfigs struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var wireguardconfigsResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "wireguardconfigs"}
+
+var wireguardconfigsKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "WireGuardConfig"}
+
+// Get takes name of the wireGuardConfig, and returns the corresponding wireGuardConfig object, and an error if there is any.
+func (c *FakeWireGuardConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WireGuardConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(wireguardconfigsResource, c.ns, name), &v1alpha1.WireGuardConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardConfig), err
+}
+
+// List takes label and field selectors, and returns the list of WireGuardConfigs that match those selectors.
+func (c *FakeWireGuardConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WireGuardConfigList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(wireguardconfigsResource, wireguardconfigsKind, c.ns, opts), &v1alpha1.WireGuardConfigList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.WireGuardConfigList{ListMeta: obj.(*v1alpha1.WireGuardConfigList).ListMeta}
+	for _, item := range obj.(*v1alpha1.WireGuardConfigList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested wireGuardConfigs.
+func (c *FakeWireGuardConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(wireguardconfigsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a wireGuardConfig and creates it.  Returns the server's representation of the wireGuardConfig, and an error, if there is any.
+func (c *FakeWireGuardConfigs) Create(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.CreateOptions) (result *v1alpha1.WireGuardConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(wireguardconfigsResource, c.ns, wireGuardConfig), &v1alpha1.WireGuardConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardConfig), err
+}
+
+// Update takes the representation of a wireGuardConfig and updates it. Returns the server's representation of the wireGuardConfig, and an error, if there is any.
+func (c *FakeWireGuardConfigs) Update(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.UpdateOptions) (result *v1alpha1.WireGuardConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(wireguardconfigsResource, c.ns, wireGuardConfig), &v1alpha1.WireGuardConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardConfig), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeWireGuardConfigs) UpdateStatus(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.UpdateOptions) (*v1alpha1.WireGuardConfig, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(wireguardconfigsResource, "status", c.ns, wireGuardConfig), &v1alpha1.WireGuardConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardConfig), err
+}
+
+// Delete takes name of the wireGuardConfig and deletes it. Returns an error if one occurs.
+func (c *FakeWireGuardConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(wireguardconfigsResource, c.ns, name), &v1alpha1.WireGuardConfig{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWireGuardConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(wireguardconfigsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.WireGuardConfigList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched wireGuardConfig.
+func (c *FakeWireGuardConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WireGuardConfig, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(wireguardconfigsResource, c.ns, name, pt, data, subresources...), &v1alpha1.WireGuardConfig{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardConfig), err
+}
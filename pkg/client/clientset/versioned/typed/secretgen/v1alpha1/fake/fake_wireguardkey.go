@@ -0,0 +1,126 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeWireGuardKeys implements WireGuardKeyInterface
+type FakeWireGuardKeys struct {
+	Fake *FakeSecretgenV1alpha1
+	ns   string
+}
+
+var wireguardkeysResource = schema.GroupVersionResource{Group: "secretgen.k14s.io", Version: "v1alpha1", Resource: "wireguardkeys"}
+
+var wireguardkeysKind = schema.GroupVersionKind{Group: "secretgen.k14s.io", Version: "v1alpha1", Kind: "WireGuardKey"}
+
+// Get takes name of the wireGuardKey, and returns the corresponding wireGuardKey object, and an error if there is any.
+func (c *FakeWireGuardKeys) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WireGuardKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(wireguardkeysResource, c.ns, name), &v1alpha1.WireGuardKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardKey), err
+}
+
+// List takes label and field selectors, and returns the list of WireGuardKeys that match those selectors.
+func (c *FakeWireGuardKeys) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WireGuardKeyList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(wireguardkeysResource, wireguardkeysKind, c.ns, opts), &v1alpha1.WireGuardKeyList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.WireGuardKeyList{ListMeta: obj.(*v1alpha1.WireGuardKeyList).ListMeta}
+	for _, item := range obj.(*v1alpha1.WireGuardKeyList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested wireGuardKeys.
+func (c *FakeWireGuardKeys) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(wireguardkeysResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a wireGuardKey and creates it.  Returns the server's representation of the wireGuardKey, and an error, if there is any.
+func (c *FakeWireGuardKeys) Create(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.CreateOptions) (result *v1alpha1.WireGuardKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(wireguardkeysResource, c.ns, wireGuardKey), &v1alpha1.WireGuardKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardKey), err
+}
+
+// Update takes the representation of a wireGuardKey and updates it. Returns the server's representation of the wireGuardKey, and an error, if there is any.
+func (c *FakeWireGuardKeys) Update(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.UpdateOptions) (result *v1alpha1.WireGuardKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(wireguardkeysResource, c.ns, wireGuardKey), &v1alpha1.WireGuardKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardKey), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeWireGuardKeys) UpdateStatus(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.UpdateOptions) (*v1alpha1.WireGuardKey, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(wireguardkeysResource, "status", c.ns, wireGuardKey), &v1alpha1.WireGuardKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardKey), err
+}
+
+// Delete takes name of the wireGuardKey and deletes it. Returns an error if one occurs.
+func (c *FakeWireGuardKeys) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteAction(wireguardkeysResource, c.ns, name), &v1alpha1.WireGuardKey{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeWireGuardKeys) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(wireguardkeysResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.WireGuardKeyList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched wireGuardKey.
+func (c *FakeWireGuardKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WireGuardKey, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(wireguardkeysResource, c.ns, name, pt, data, subresources...), &v1alpha1.WireGuardKey{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.WireGuardKey), err
+}
@@ -2,10 +2,42 @@
 
 package v1alpha1
 
+type AgeKeyExpansion interface{}
+
 type CertificateExpansion interface{}
 
+type DHParamExpansion interface{}
+
+type DockerConfigExpansion interface{}
+
+type EncryptionKeyringExpansion interface{}
+
+type HtpasswdExpansion interface{}
+
+type JSONWebKeySetExpansion interface{}
+
+type JSONWebTokenExpansion interface{}
+
+type KeyPairExpansion interface{}
+
+type OpenPGPKeyExpansion interface{}
+
 type PasswordExpansion interface{}
 
 type RSAKeyExpansion interface{}
 
+type RandomTokenExpansion interface{}
+
+type SSHCertificateExpansion interface{}
+
 type SSHKeyExpansion interface{}
+
+type ServiceAccountKubeconfigExpansion interface{}
+
+type TLSPairExpansion interface{}
+
+type TOTPSeedExpansion interface{}
+
+type WireGuardConfigExpansion interface{}
+
+type WireGuardKeyExpansion interface{}
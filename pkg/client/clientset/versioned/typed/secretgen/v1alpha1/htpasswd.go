@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// HtpasswdsGetter has a method to return a HtpasswdInterface.
+// A group's client should implement this interface.
+type HtpasswdsGetter interface {
+	Htpasswds(namespace string) HtpasswdInterface
+}
+
+// HtpasswdInterface has methods to work with Htpasswd resources.
+type HtpasswdInterface interface {
+	Create(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.CreateOptions) (*v1alpha1.Htpasswd, error)
+	Update(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.UpdateOptions) (*v1alpha1.Htpasswd, error)
+	UpdateStatus(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.UpdateOptions) (*v1alpha1.Htpasswd, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.Htpasswd, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.HtpasswdList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Htpasswd, err error)
+	HtpasswdExpansion
+}
+
+// htpasswds implements HtpasswdInterface
+type htpasswds struct {
+	client rest.Interface
+	ns     string
+}
+
+// newHtpasswds returns a Htpasswds
+func newHtpasswds(c *SecretgenV1alpha1Client, namespace string) *htpasswds {
+	return &htpasswds{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the htpasswd, and returns the corresponding htpasswd object, and an error if there is any.
+func (c *htpasswds) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Htpasswd, err error) {
+	result = &v1alpha1.Htpasswd{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Htpasswds that match those selectors.
+func (c *htpasswds) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.HtpasswdList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.HtpasswdList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested htpasswds.
+func (c *htpasswds) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a htpasswd and creates it.  Returns the server's representation of the htpasswd, and an error, if there is any.
+func (c *htpasswds) Create(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.CreateOptions) (result *v1alpha1.Htpasswd, err error) {
+	result = &v1alpha1.Htpasswd{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(htpasswd).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a htpasswd and updates it. Returns the server's representation of the htpasswd, and an error, if there is any.
+func (c *htpasswds) Update(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.UpdateOptions) (result *v1alpha1.Htpasswd, err error) {
+	result = &v1alpha1.Htpasswd{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		Name(htpasswd.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(htpasswd).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *htpasswds) UpdateStatus(ctx context.Context, htpasswd *v1alpha1.Htpasswd, opts v1.UpdateOptions) (result *v1alpha1.Htpasswd, err error) {
+	result = &v1alpha1.Htpasswd{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		Name(htpasswd.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(htpasswd).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the htpasswd and deletes it. Returns an error if one occurs.
+func (c *htpasswds) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *htpasswds) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("htpasswds").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched htpasswd.
+func (c *htpasswds) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Htpasswd, err error) {
+	result = &v1alpha1.Htpasswd{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("htpasswds").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// JSONWebKeySetsGetter has a method to return a JSONWebKeySetInterface.
+// A group's client should implement this interface.
+type JSONWebKeySetsGetter interface {
+	JSONWebKeySets(namespace string) JSONWebKeySetInterface
+}
+
+// JSONWebKeySetInterface has methods to work with JSONWebKeySet resources.
+type JSONWebKeySetInterface interface {
+	Create(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.CreateOptions) (*v1alpha1.JSONWebKeySet, error)
+	Update(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.UpdateOptions) (*v1alpha1.JSONWebKeySet, error)
+	UpdateStatus(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.UpdateOptions) (*v1alpha1.JSONWebKeySet, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.JSONWebKeySet, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.JSONWebKeySetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONWebKeySet, err error)
+	JSONWebKeySetExpansion
+}
+
+// jSONWebKeySets implements JSONWebKeySetInterface
+type jSONWebKeySets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newJSONWebKeySets returns a JSONWebKeySets
+func newJSONWebKeySets(c *SecretgenV1alpha1Client, namespace string) *jSONWebKeySets {
+	return &jSONWebKeySets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the jSONWebKeySet, and returns the corresponding jSONWebKeySet object, and an error if there is any.
+func (c *jSONWebKeySets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.JSONWebKeySet, err error) {
+	result = &v1alpha1.JSONWebKeySet{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of JSONWebKeySets that match those selectors.
+func (c *jSONWebKeySets) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.JSONWebKeySetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.JSONWebKeySetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested jSONWebKeySets.
+func (c *jSONWebKeySets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a jSONWebKeySet and creates it.  Returns the server's representation of the jSONWebKeySet, and an error, if there is any.
+func (c *jSONWebKeySets) Create(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.CreateOptions) (result *v1alpha1.JSONWebKeySet, err error) {
+	result = &v1alpha1.JSONWebKeySet{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONWebKeySet).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a jSONWebKeySet and updates it. Returns the server's representation of the jSONWebKeySet, and an error, if there is any.
+func (c *jSONWebKeySets) Update(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.UpdateOptions) (result *v1alpha1.JSONWebKeySet, err error) {
+	result = &v1alpha1.JSONWebKeySet{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		Name(jSONWebKeySet.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONWebKeySet).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *jSONWebKeySets) UpdateStatus(ctx context.Context, jSONWebKeySet *v1alpha1.JSONWebKeySet, opts v1.UpdateOptions) (result *v1alpha1.JSONWebKeySet, err error) {
+	result = &v1alpha1.JSONWebKeySet{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		Name(jSONWebKeySet.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONWebKeySet).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the jSONWebKeySet and deletes it. Returns an error if one occurs.
+func (c *jSONWebKeySets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *jSONWebKeySets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched jSONWebKeySet.
+func (c *jSONWebKeySets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONWebKeySet, err error) {
+	result = &v1alpha1.JSONWebKeySet{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("jsonwebkeysets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
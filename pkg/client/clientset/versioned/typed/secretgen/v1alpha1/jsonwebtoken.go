@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// JSONWebTokensGetter has a method to return a JSONWebTokenInterface.
+// A group's client should implement this interface.
+type JSONWebTokensGetter interface {
+	JSONWebTokens(namespace string) JSONWebTokenInterface
+}
+
+// JSONWebTokenInterface has methods to work with JSONWebToken resources.
+type JSONWebTokenInterface interface {
+	Create(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.CreateOptions) (*v1alpha1.JSONWebToken, error)
+	Update(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.UpdateOptions) (*v1alpha1.JSONWebToken, error)
+	UpdateStatus(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.UpdateOptions) (*v1alpha1.JSONWebToken, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.JSONWebToken, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.JSONWebTokenList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONWebToken, err error)
+	JSONWebTokenExpansion
+}
+
+// jSONWebTokens implements JSONWebTokenInterface
+type jSONWebTokens struct {
+	client rest.Interface
+	ns     string
+}
+
+// newJSONWebTokens returns a JSONWebTokens
+func newJSONWebTokens(c *SecretgenV1alpha1Client, namespace string) *jSONWebTokens {
+	return &jSONWebTokens{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the jSONWebToken, and returns the corresponding jSONWebToken object, and an error if there is any.
+func (c *jSONWebTokens) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.JSONWebToken, err error) {
+	result = &v1alpha1.JSONWebToken{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of JSONWebTokens that match those selectors.
+func (c *jSONWebTokens) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.JSONWebTokenList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.JSONWebTokenList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested jSONWebTokens.
+func (c *jSONWebTokens) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a jSONWebToken and creates it.  Returns the server's representation of the jSONWebToken, and an error, if there is any.
+func (c *jSONWebTokens) Create(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.CreateOptions) (result *v1alpha1.JSONWebToken, err error) {
+	result = &v1alpha1.JSONWebToken{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONWebToken).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a jSONWebToken and updates it. Returns the server's representation of the jSONWebToken, and an error, if there is any.
+func (c *jSONWebTokens) Update(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.UpdateOptions) (result *v1alpha1.JSONWebToken, err error) {
+	result = &v1alpha1.JSONWebToken{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		Name(jSONWebToken.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONWebToken).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *jSONWebTokens) UpdateStatus(ctx context.Context, jSONWebToken *v1alpha1.JSONWebToken, opts v1.UpdateOptions) (result *v1alpha1.JSONWebToken, err error) {
+	result = &v1alpha1.JSONWebToken{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		Name(jSONWebToken.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(jSONWebToken).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the jSONWebToken and deletes it. Returns an error if one occurs.
+func (c *jSONWebTokens) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *jSONWebTokens) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched jSONWebToken.
+func (c *jSONWebTokens) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.JSONWebToken, err error) {
+	result = &v1alpha1.JSONWebToken{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("jsonwebtokens").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// KeyPairsGetter has a method to return a KeyPairInterface.
+// A group's client should implement this interface.
+type KeyPairsGetter interface {
+	KeyPairs(namespace string) KeyPairInterface
+}
+
+// KeyPairInterface has methods to work with KeyPair resources.
+type KeyPairInterface interface {
+	Create(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.CreateOptions) (*v1alpha1.KeyPair, error)
+	Update(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.UpdateOptions) (*v1alpha1.KeyPair, error)
+	UpdateStatus(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.UpdateOptions) (*v1alpha1.KeyPair, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.KeyPair, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.KeyPairList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KeyPair, err error)
+	KeyPairExpansion
+}
+
+// keyPairs implements KeyPairInterface
+type keyPairs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newKeyPairs returns a KeyPairs
+func newKeyPairs(c *SecretgenV1alpha1Client, namespace string) *keyPairs {
+	return &keyPairs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the keyPair, and returns the corresponding keyPair object, and an error if there is any.
+func (c *keyPairs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.KeyPair, err error) {
+	result = &v1alpha1.KeyPair{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("keypairs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of KeyPairs that match those selectors.
+func (c *keyPairs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.KeyPairList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.KeyPairList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("keypairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested keyPairs.
+func (c *keyPairs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("keypairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a keyPair and creates it.  Returns the server's representation of the keyPair, and an error, if there is any.
+func (c *keyPairs) Create(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.CreateOptions) (result *v1alpha1.KeyPair, err error) {
+	result = &v1alpha1.KeyPair{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("keypairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(keyPair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a keyPair and updates it. Returns the server's representation of the keyPair, and an error, if there is any.
+func (c *keyPairs) Update(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.UpdateOptions) (result *v1alpha1.KeyPair, err error) {
+	result = &v1alpha1.KeyPair{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("keypairs").
+		Name(keyPair.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(keyPair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *keyPairs) UpdateStatus(ctx context.Context, keyPair *v1alpha1.KeyPair, opts v1.UpdateOptions) (result *v1alpha1.KeyPair, err error) {
+	result = &v1alpha1.KeyPair{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("keypairs").
+		Name(keyPair.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(keyPair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the keyPair and deletes it. Returns an error if one occurs.
+func (c *keyPairs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("keypairs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *keyPairs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("keypairs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched keyPair.
+func (c *keyPairs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.KeyPair, err error) {
+	result = &v1alpha1.KeyPair{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("keypairs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
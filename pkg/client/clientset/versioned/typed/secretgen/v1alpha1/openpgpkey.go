@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// OpenPGPKeysGetter has a method to return a OpenPGPKeyInterface.
+// A group's client should implement this interface.
+type OpenPGPKeysGetter interface {
+	OpenPGPKeys(namespace string) OpenPGPKeyInterface
+}
+
+// OpenPGPKeyInterface has methods to work with OpenPGPKey resources.
+type OpenPGPKeyInterface interface {
+	Create(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.CreateOptions) (*v1alpha1.OpenPGPKey, error)
+	Update(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.UpdateOptions) (*v1alpha1.OpenPGPKey, error)
+	UpdateStatus(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.UpdateOptions) (*v1alpha1.OpenPGPKey, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.OpenPGPKey, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.OpenPGPKeyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.OpenPGPKey, err error)
+	OpenPGPKeyExpansion
+}
+
+// openPGPKeys implements OpenPGPKeyInterface
+type openPGPKeys struct {
+	client rest.Interface
+	ns     string
+}
+
+// newOpenPGPKeys returns a OpenPGPKeys
+func newOpenPGPKeys(c *SecretgenV1alpha1Client, namespace string) *openPGPKeys {
+	return &openPGPKeys{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the openPGPKey, and returns the corresponding openPGPKey object, and an error if there is any.
+func (c *openPGPKeys) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.OpenPGPKey, err error) {
+	result = &v1alpha1.OpenPGPKey{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of OpenPGPKeys that match those selectors.
+func (c *openPGPKeys) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.OpenPGPKeyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.OpenPGPKeyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested openPGPKeys.
+func (c *openPGPKeys) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a openPGPKey and creates it.  Returns the server's representation of the openPGPKey, and an error, if there is any.
+func (c *openPGPKeys) Create(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.CreateOptions) (result *v1alpha1.OpenPGPKey, err error) {
+	result = &v1alpha1.OpenPGPKey{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(openPGPKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a openPGPKey and updates it. Returns the server's representation of the openPGPKey, and an error, if there is any.
+func (c *openPGPKeys) Update(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.UpdateOptions) (result *v1alpha1.OpenPGPKey, err error) {
+	result = &v1alpha1.OpenPGPKey{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		Name(openPGPKey.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(openPGPKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *openPGPKeys) UpdateStatus(ctx context.Context, openPGPKey *v1alpha1.OpenPGPKey, opts v1.UpdateOptions) (result *v1alpha1.OpenPGPKey, err error) {
+	result = &v1alpha1.OpenPGPKey{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		Name(openPGPKey.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(openPGPKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the openPGPKey and deletes it. Returns an error if one occurs.
+func (c *openPGPKeys) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *openPGPKeys) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched openPGPKey.
+func (c *openPGPKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.OpenPGPKey, err error) {
+	result = &v1alpha1.OpenPGPKey{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("openpgpkeys").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
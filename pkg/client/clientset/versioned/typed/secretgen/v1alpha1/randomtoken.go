@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// RandomTokensGetter has a method to return a RandomTokenInterface.
+// A group's client should implement this interface.
+type RandomTokensGetter interface {
+	RandomTokens(namespace string) RandomTokenInterface
+}
+
+// RandomTokenInterface has methods to work with RandomToken resources.
+type RandomTokenInterface interface {
+	Create(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.CreateOptions) (*v1alpha1.RandomToken, error)
+	Update(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.UpdateOptions) (*v1alpha1.RandomToken, error)
+	UpdateStatus(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.UpdateOptions) (*v1alpha1.RandomToken, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.RandomToken, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.RandomTokenList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RandomToken, err error)
+	RandomTokenExpansion
+}
+
+// randomTokens implements RandomTokenInterface
+type randomTokens struct {
+	client rest.Interface
+	ns     string
+}
+
+// newRandomTokens returns a RandomTokens
+func newRandomTokens(c *SecretgenV1alpha1Client, namespace string) *randomTokens {
+	return &randomTokens{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the randomToken, and returns the corresponding randomToken object, and an error if there is any.
+func (c *randomTokens) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.RandomToken, err error) {
+	result = &v1alpha1.RandomToken{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of RandomTokens that match those selectors.
+func (c *randomTokens) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.RandomTokenList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.RandomTokenList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested randomTokens.
+func (c *randomTokens) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a randomToken and creates it.  Returns the server's representation of the randomToken, and an error, if there is any.
+func (c *randomTokens) Create(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.CreateOptions) (result *v1alpha1.RandomToken, err error) {
+	result = &v1alpha1.RandomToken{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(randomToken).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a randomToken and updates it. Returns the server's representation of the randomToken, and an error, if there is any.
+func (c *randomTokens) Update(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.UpdateOptions) (result *v1alpha1.RandomToken, err error) {
+	result = &v1alpha1.RandomToken{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		Name(randomToken.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(randomToken).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *randomTokens) UpdateStatus(ctx context.Context, randomToken *v1alpha1.RandomToken, opts v1.UpdateOptions) (result *v1alpha1.RandomToken, err error) {
+	result = &v1alpha1.RandomToken{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		Name(randomToken.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(randomToken).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the randomToken and deletes it. Returns an error if one occurs.
+func (c *randomTokens) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *randomTokens) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("randomtokens").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched randomToken.
+func (c *randomTokens) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.RandomToken, err error) {
+	result = &v1alpha1.RandomToken{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("randomtokens").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
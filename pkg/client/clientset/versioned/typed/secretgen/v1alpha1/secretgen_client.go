@@ -10,10 +10,26 @@ import (
 
 type SecretgenV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	AgeKeysGetter
 	CertificatesGetter
+	DHParamsGetter
+	DockerConfigsGetter
+	EncryptionKeyringsGetter
+	HtpasswdsGetter
+	JSONWebKeySetsGetter
+	JSONWebTokensGetter
+	KeyPairsGetter
+	OpenPGPKeysGetter
 	PasswordsGetter
 	RSAKeysGetter
+	RandomTokensGetter
+	SSHCertificatesGetter
 	SSHKeysGetter
+	ServiceAccountKubeconfigsGetter
+	TLSPairsGetter
+	TOTPSeedsGetter
+	WireGuardConfigsGetter
+	WireGuardKeysGetter
 }
 
 // SecretgenV1alpha1Client is used to interact with features provided by the secretgen.k14s.io group.
@@ -21,10 +37,46 @@ type SecretgenV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *SecretgenV1alpha1Client) AgeKeys(namespace string) AgeKeyInterface {
+	return newAgeKeys(c, namespace)
+}
+
 func (c *SecretgenV1alpha1Client) Certificates(namespace string) CertificateInterface {
 	return newCertificates(c, namespace)
 }
 
+func (c *SecretgenV1alpha1Client) DHParams(namespace string) DHParamInterface {
+	return newDHParams(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) DockerConfigs(namespace string) DockerConfigInterface {
+	return newDockerConfigs(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) EncryptionKeyrings(namespace string) EncryptionKeyringInterface {
+	return newEncryptionKeyrings(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) Htpasswds(namespace string) HtpasswdInterface {
+	return newHtpasswds(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) JSONWebKeySets(namespace string) JSONWebKeySetInterface {
+	return newJSONWebKeySets(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) JSONWebTokens(namespace string) JSONWebTokenInterface {
+	return newJSONWebTokens(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) KeyPairs(namespace string) KeyPairInterface {
+	return newKeyPairs(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) OpenPGPKeys(namespace string) OpenPGPKeyInterface {
+	return newOpenPGPKeys(c, namespace)
+}
+
 func (c *SecretgenV1alpha1Client) Passwords(namespace string) PasswordInterface {
 	return newPasswords(c, namespace)
 }
@@ -33,10 +85,38 @@ func (c *SecretgenV1alpha1Client) RSAKeys(namespace string) RSAKeyInterface {
 	return newRSAKeys(c, namespace)
 }
 
+func (c *SecretgenV1alpha1Client) RandomTokens(namespace string) RandomTokenInterface {
+	return newRandomTokens(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) SSHCertificates(namespace string) SSHCertificateInterface {
+	return newSSHCertificates(c, namespace)
+}
+
 func (c *SecretgenV1alpha1Client) SSHKeys(namespace string) SSHKeyInterface {
 	return newSSHKeys(c, namespace)
 }
 
+func (c *SecretgenV1alpha1Client) ServiceAccountKubeconfigs(namespace string) ServiceAccountKubeconfigInterface {
+	return newServiceAccountKubeconfigs(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) TLSPairs(namespace string) TLSPairInterface {
+	return newTLSPairs(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) TOTPSeeds(namespace string) TOTPSeedInterface {
+	return newTOTPSeeds(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) WireGuardConfigs(namespace string) WireGuardConfigInterface {
+	return newWireGuardConfigs(c, namespace)
+}
+
+func (c *SecretgenV1alpha1Client) WireGuardKeys(namespace string) WireGuardKeyInterface {
+	return newWireGuardKeys(c, namespace)
+}
+
 // NewForConfig creates a new SecretgenV1alpha1Client for the given config.
 func NewForConfig(c *rest.Config) (*SecretgenV1alpha1Client, error) {
 	config := *c
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// ServiceAccountKubeconfigsGetter has a method to return a ServiceAccountKubeconfigInterface.
+// A group's client should implement this interface.
+type ServiceAccountKubeconfigsGetter interface {
+	ServiceAccountKubeconfigs(namespace string) ServiceAccountKubeconfigInterface
+}
+
+// ServiceAccountKubeconfigInterface has methods to work with ServiceAccountKubeconfig resources.
+type ServiceAccountKubeconfigInterface interface {
+	Create(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.CreateOptions) (*v1alpha1.ServiceAccountKubeconfig, error)
+	Update(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.UpdateOptions) (*v1alpha1.ServiceAccountKubeconfig, error)
+	UpdateStatus(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.UpdateOptions) (*v1alpha1.ServiceAccountKubeconfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.ServiceAccountKubeconfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.ServiceAccountKubeconfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ServiceAccountKubeconfig, err error)
+	ServiceAccountKubeconfigExpansion
+}
+
+// serviceAccountKubeconfigs implements ServiceAccountKubeconfigInterface
+type serviceAccountKubeconfigs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newServiceAccountKubeconfigs returns a ServiceAccountKubeconfigs
+func newServiceAccountKubeconfigs(c *SecretgenV1alpha1Client, namespace string) *serviceAccountKubeconfigs {
+	return &serviceAccountKubeconfigs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the serviceAccountKubeconfig, and returns the corresponding serviceAccountKubeconfig object, and an error if there is any.
+func (c *serviceAccountKubeconfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	result = &v1alpha1.ServiceAccountKubeconfig{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of ServiceAccountKubeconfigs that match those selectors.
+func (c *serviceAccountKubeconfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.ServiceAccountKubeconfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.ServiceAccountKubeconfigList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested serviceAccountKubeconfigs.
+func (c *serviceAccountKubeconfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a serviceAccountKubeconfig and creates it.  Returns the server's representation of the serviceAccountKubeconfig, and an error, if there is any.
+func (c *serviceAccountKubeconfigs) Create(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.CreateOptions) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	result = &v1alpha1.ServiceAccountKubeconfig{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(serviceAccountKubeconfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a serviceAccountKubeconfig and updates it. Returns the server's representation of the serviceAccountKubeconfig, and an error, if there is any.
+func (c *serviceAccountKubeconfigs) Update(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.UpdateOptions) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	result = &v1alpha1.ServiceAccountKubeconfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		Name(serviceAccountKubeconfig.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(serviceAccountKubeconfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *serviceAccountKubeconfigs) UpdateStatus(ctx context.Context, serviceAccountKubeconfig *v1alpha1.ServiceAccountKubeconfig, opts v1.UpdateOptions) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	result = &v1alpha1.ServiceAccountKubeconfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		Name(serviceAccountKubeconfig.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(serviceAccountKubeconfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the serviceAccountKubeconfig and deletes it. Returns an error if one occurs.
+func (c *serviceAccountKubeconfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *serviceAccountKubeconfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched serviceAccountKubeconfig.
+func (c *serviceAccountKubeconfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.ServiceAccountKubeconfig, err error) {
+	result = &v1alpha1.ServiceAccountKubeconfig{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("serviceaccountkubeconfigs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// SSHCertificatesGetter has a method to return a SSHCertificateInterface.
+// A group's client should implement this interface.
+type SSHCertificatesGetter interface {
+	SSHCertificates(namespace string) SSHCertificateInterface
+}
+
+// SSHCertificateInterface has methods to work with SSHCertificate resources.
+type SSHCertificateInterface interface {
+	Create(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.CreateOptions) (*v1alpha1.SSHCertificate, error)
+	Update(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.UpdateOptions) (*v1alpha1.SSHCertificate, error)
+	UpdateStatus(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.UpdateOptions) (*v1alpha1.SSHCertificate, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.SSHCertificate, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.SSHCertificateList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.SSHCertificate, err error)
+	SSHCertificateExpansion
+}
+
+// sSHCertificates implements SSHCertificateInterface
+type sSHCertificates struct {
+	client rest.Interface
+	ns     string
+}
+
+// newSSHCertificates returns a SSHCertificates
+func newSSHCertificates(c *SecretgenV1alpha1Client, namespace string) *sSHCertificates {
+	return &sSHCertificates{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the sSHCertificate, and returns the corresponding sSHCertificate object, and an error if there is any.
+func (c *sSHCertificates) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.SSHCertificate, err error) {
+	result = &v1alpha1.SSHCertificate{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of SSHCertificates that match those selectors.
+func (c *sSHCertificates) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.SSHCertificateList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.SSHCertificateList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested sSHCertificates.
+func (c *sSHCertificates) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a sSHCertificate and creates it.  Returns the server's representation of the sSHCertificate, and an error, if there is any.
+func (c *sSHCertificates) Create(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.CreateOptions) (result *v1alpha1.SSHCertificate, err error) {
+	result = &v1alpha1.SSHCertificate{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(sSHCertificate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a sSHCertificate and updates it. Returns the server's representation of the sSHCertificate, and an error, if there is any.
+func (c *sSHCertificates) Update(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.UpdateOptions) (result *v1alpha1.SSHCertificate, err error) {
+	result = &v1alpha1.SSHCertificate{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		Name(sSHCertificate.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(sSHCertificate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *sSHCertificates) UpdateStatus(ctx context.Context, sSHCertificate *v1alpha1.SSHCertificate, opts v1.UpdateOptions) (result *v1alpha1.SSHCertificate, err error) {
+	result = &v1alpha1.SSHCertificate{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		Name(sSHCertificate.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(sSHCertificate).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the sSHCertificate and deletes it. Returns an error if one occurs.
+func (c *sSHCertificates) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *sSHCertificates) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched sSHCertificate.
+func (c *sSHCertificates) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.SSHCertificate, err error) {
+	result = &v1alpha1.SSHCertificate{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("sshcertificates").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
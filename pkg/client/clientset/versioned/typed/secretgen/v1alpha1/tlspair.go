@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// TLSPairsGetter has a method to return a TLSPairInterface.
+// A group's client should implement this interface.
+type TLSPairsGetter interface {
+	TLSPairs(namespace string) TLSPairInterface
+}
+
+// TLSPairInterface has methods to work with TLSPair resources.
+type TLSPairInterface interface {
+	Create(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.CreateOptions) (*v1alpha1.TLSPair, error)
+	Update(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.UpdateOptions) (*v1alpha1.TLSPair, error)
+	UpdateStatus(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.UpdateOptions) (*v1alpha1.TLSPair, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.TLSPair, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.TLSPairList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TLSPair, err error)
+	TLSPairExpansion
+}
+
+// tLSPairs implements TLSPairInterface
+type tLSPairs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newTLSPairs returns a TLSPairs
+func newTLSPairs(c *SecretgenV1alpha1Client, namespace string) *tLSPairs {
+	return &tLSPairs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the tLSPair, and returns the corresponding tLSPair object, and an error if there is any.
+func (c *tLSPairs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.TLSPair, err error) {
+	result = &v1alpha1.TLSPair{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of TLSPairs that match those selectors.
+func (c *tLSPairs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.TLSPairList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.TLSPairList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested tLSPairs.
+func (c *tLSPairs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a tLSPair and creates it.  Returns the server's representation of the tLSPair, and an error, if there is any.
+func (c *tLSPairs) Create(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.CreateOptions) (result *v1alpha1.TLSPair, err error) {
+	result = &v1alpha1.TLSPair{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(tLSPair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a tLSPair and updates it. Returns the server's representation of the tLSPair, and an error, if there is any.
+func (c *tLSPairs) Update(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.UpdateOptions) (result *v1alpha1.TLSPair, err error) {
+	result = &v1alpha1.TLSPair{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		Name(tLSPair.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(tLSPair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *tLSPairs) UpdateStatus(ctx context.Context, tLSPair *v1alpha1.TLSPair, opts v1.UpdateOptions) (result *v1alpha1.TLSPair, err error) {
+	result = &v1alpha1.TLSPair{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		Name(tLSPair.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(tLSPair).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the tLSPair and deletes it. Returns an error if one occurs.
+func (c *tLSPairs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *tLSPairs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("tlspairs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched tLSPair.
+func (c *tLSPairs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.TLSPair, err error) {
+	result = &v1alpha1.TLSPair{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("tlspairs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WireGuardConfigsGetter has a method to return a WireGuardConfigInterface.
+// A group's client should implement this interface.
+type WireGuardConfigsGetter interface {
+	WireGuardConfigs(namespace string) WireGuardConfigInterface
+}
+
+// WireGuardConfigInterface has methods to work with WireGuardConfig resources.
+type WireGuardConfigInterface interface {
+	Create(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.CreateOptions) (*v1alpha1.WireGuardConfig, error)
+	Update(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.UpdateOptions) (*v1alpha1.WireGuardConfig, error)
+	UpdateStatus(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.UpdateOptions) (*v1alpha1.WireGuardConfig, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.WireGuardConfig, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.WireGuardConfigList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WireGuardConfig, err error)
+	WireGuardConfigExpansion
+}
+
+// wireGuardConfigs implements WireGuardConfigInterface
+type wireGuardConfigs struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWireGuardConfigs returns a WireGuardConfigs
+func newWireGuardConfigs(c *SecretgenV1alpha1Client, namespace string) *wireGuardConfigs {
+	return &wireGuardConfigs{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the wireGuardConfig, and returns the corresponding wireGuardConfig object, and an error if there is any.
+func (c *wireGuardConfigs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WireGuardConfig, err error) {
+	result = &v1alpha1.WireGuardConfig{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WireGuardConfigs that match those selectors.
+func (c *wireGuardConfigs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WireGuardConfigList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.WireGuardConfigList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested wireGuardConfigs.
+func (c *wireGuardConfigs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a wireGuardConfig and creates it.  Returns the server's representation of the wireGuardConfig, and an error, if there is any.
+func (c *wireGuardConfigs) Create(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.CreateOptions) (result *v1alpha1.WireGuardConfig, err error) {
+	result = &v1alpha1.WireGuardConfig{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(wireGuardConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a wireGuardConfig and updates it. Returns the server's representation of the wireGuardConfig, and an error, if there is any.
+func (c *wireGuardConfigs) Update(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.UpdateOptions) (result *v1alpha1.WireGuardConfig, err error) {
+	result = &v1alpha1.WireGuardConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		Name(wireGuardConfig.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(wireGuardConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *wireGuardConfigs) UpdateStatus(ctx context.Context, wireGuardConfig *v1alpha1.WireGuardConfig, opts v1.UpdateOptions) (result *v1alpha1.WireGuardConfig, err error) {
+	result = &v1alpha1.WireGuardConfig{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		Name(wireGuardConfig.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(wireGuardConfig).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the wireGuardConfig and deletes it. Returns an error if one occurs.
+func (c *wireGuardConfigs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *wireGuardConfigs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched wireGuardConfig.
+func (c *wireGuardConfigs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WireGuardConfig, err error) {
+	result = &v1alpha1.WireGuardConfig{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("wireguardconfigs").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
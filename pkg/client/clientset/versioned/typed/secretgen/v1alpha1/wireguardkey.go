@@ -0,0 +1,179 @@
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	scheme "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// WireGuardKeysGetter has a method to return a WireGuardKeyInterface.
+// A group's client should implement this interface.
+type WireGuardKeysGetter interface {
+	WireGuardKeys(namespace string) WireGuardKeyInterface
+}
+
+// WireGuardKeyInterface has methods to work with WireGuardKey resources.
+type WireGuardKeyInterface interface {
+	Create(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.CreateOptions) (*v1alpha1.WireGuardKey, error)
+	Update(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.UpdateOptions) (*v1alpha1.WireGuardKey, error)
+	UpdateStatus(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.UpdateOptions) (*v1alpha1.WireGuardKey, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.WireGuardKey, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.WireGuardKeyList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WireGuardKey, err error)
+	WireGuardKeyExpansion
+}
+
+// wireGuardKeys implements WireGuardKeyInterface
+type wireGuardKeys struct {
+	client rest.Interface
+	ns     string
+}
+
+// newWireGuardKeys returns a WireGuardKeys
+func newWireGuardKeys(c *SecretgenV1alpha1Client, namespace string) *wireGuardKeys {
+	return &wireGuardKeys{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the wireGuardKey, and returns the corresponding wireGuardKey object, and an error if there is any.
+func (c *wireGuardKeys) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.WireGuardKey, err error) {
+	result = &v1alpha1.WireGuardKey{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of WireGuardKeys that match those selectors.
+func (c *wireGuardKeys) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.WireGuardKeyList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.WireGuardKeyList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested wireGuardKeys.
+func (c *wireGuardKeys) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a wireGuardKey and creates it.  Returns the server's representation of the wireGuardKey, and an error, if there is any.
+func (c *wireGuardKeys) Create(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.CreateOptions) (result *v1alpha1.WireGuardKey, err error) {
+	result = &v1alpha1.WireGuardKey{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(wireGuardKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a wireGuardKey and updates it. Returns the server's representation of the wireGuardKey, and an error, if there is any.
+func (c *wireGuardKeys) Update(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.UpdateOptions) (result *v1alpha1.WireGuardKey, err error) {
+	result = &v1alpha1.WireGuardKey{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		Name(wireGuardKey.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(wireGuardKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *wireGuardKeys) UpdateStatus(ctx context.Context, wireGuardKey *v1alpha1.WireGuardKey, opts v1.UpdateOptions) (result *v1alpha1.WireGuardKey, err error) {
+	result = &v1alpha1.WireGuardKey{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		Name(wireGuardKey.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(wireGuardKey).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the wireGuardKey and deletes it. Returns an error if one occurs.
+func (c *wireGuardKeys) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *wireGuardKeys) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched wireGuardKey.
+func (c *wireGuardKeys) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.WireGuardKey, err error) {
+	result = &v1alpha1.WireGuardKey{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("wireguardkeys").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -37,14 +37,46 @@ func (f *genericInformer) Lister() cache.GenericLister {
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
 	// Group=secretgen.k14s.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("agekeys"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().AgeKeys().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("certificates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().Certificates().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("dhparams"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().DHParams().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("dockerconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().DockerConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("encryptionkeyrings"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().EncryptionKeyrings().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("htpasswds"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().Htpasswds().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("jsonwebkeysets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().JSONWebKeySets().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("jsonwebtokens"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().JSONWebTokens().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("keypairs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().KeyPairs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("openpgpkeys"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().OpenPGPKeys().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("passwords"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().Passwords().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("rsakeys"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().RSAKeys().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("randomtokens"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().RandomTokens().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("sshcertificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().SSHCertificates().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("sshkeys"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().SSHKeys().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("serviceaccountkubeconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().ServiceAccountKubeconfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("tlspairs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().TLSPairs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("totpseeds"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().TOTPSeeds().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("wireguardconfigs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().WireGuardConfigs().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("wireguardkeys"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Secretgen().V1alpha1().WireGuardKeys().Informer()}, nil
 
 	}
 
@@ -0,0 +1,74 @@
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	secretgenv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	versioned "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	internalinterfaces "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/listers/secretgen/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EncryptionKeyringInformer provides access to a shared informer and lister for
+// EncryptionKeyrings.
+type EncryptionKeyringInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.EncryptionKeyringLister
+}
+
+type encryptionKeyringInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewEncryptionKeyringInformer constructs a new informer for EncryptionKeyring type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewEncryptionKeyringInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredEncryptionKeyringInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredEncryptionKeyringInformer constructs a new informer for EncryptionKeyring type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredEncryptionKeyringInformer(client versioned.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SecretgenV1alpha1().EncryptionKeyrings(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.SecretgenV1alpha1().EncryptionKeyrings(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&secretgenv1alpha1.EncryptionKeyring{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *encryptionKeyringInformer) defaultInformer(client versioned.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredEncryptionKeyringInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *encryptionKeyringInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&secretgenv1alpha1.EncryptionKeyring{}, f.defaultInformer)
+}
+
+func (f *encryptionKeyringInformer) Lister() v1alpha1.EncryptionKeyringLister {
+	return v1alpha1.NewEncryptionKeyringLister(f.Informer().GetIndexer())
+}
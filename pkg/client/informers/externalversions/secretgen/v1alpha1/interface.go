@@ -8,14 +8,46 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// AgeKeys returns a AgeKeyInformer.
+	AgeKeys() AgeKeyInformer
 	// Certificates returns a CertificateInformer.
 	Certificates() CertificateInformer
+	// DHParams returns a DHParamInformer.
+	DHParams() DHParamInformer
+	// DockerConfigs returns a DockerConfigInformer.
+	DockerConfigs() DockerConfigInformer
+	// EncryptionKeyrings returns a EncryptionKeyringInformer.
+	EncryptionKeyrings() EncryptionKeyringInformer
+	// Htpasswds returns a HtpasswdInformer.
+	Htpasswds() HtpasswdInformer
+	// JSONWebKeySets returns a JSONWebKeySetInformer.
+	JSONWebKeySets() JSONWebKeySetInformer
+	// JSONWebTokens returns a JSONWebTokenInformer.
+	JSONWebTokens() JSONWebTokenInformer
+	// KeyPairs returns a KeyPairInformer.
+	KeyPairs() KeyPairInformer
+	// OpenPGPKeys returns a OpenPGPKeyInformer.
+	OpenPGPKeys() OpenPGPKeyInformer
 	// Passwords returns a PasswordInformer.
 	Passwords() PasswordInformer
 	// RSAKeys returns a RSAKeyInformer.
 	RSAKeys() RSAKeyInformer
+	// RandomTokens returns a RandomTokenInformer.
+	RandomTokens() RandomTokenInformer
+	// SSHCertificates returns a SSHCertificateInformer.
+	SSHCertificates() SSHCertificateInformer
 	// SSHKeys returns a SSHKeyInformer.
 	SSHKeys() SSHKeyInformer
+	// ServiceAccountKubeconfigs returns a ServiceAccountKubeconfigInformer.
+	ServiceAccountKubeconfigs() ServiceAccountKubeconfigInformer
+	// TLSPairs returns a TLSPairInformer.
+	TLSPairs() TLSPairInformer
+	// TOTPSeeds returns a TOTPSeedInformer.
+	TOTPSeeds() TOTPSeedInformer
+	// WireGuardConfigs returns a WireGuardConfigInformer.
+	WireGuardConfigs() WireGuardConfigInformer
+	// WireGuardKeys returns a WireGuardKeyInformer.
+	WireGuardKeys() WireGuardKeyInformer
 }
 
 type version struct {
@@ -29,11 +61,56 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// AgeKeys returns a AgeKeyInformer.
+func (v *version) AgeKeys() AgeKeyInformer {
+	return &ageKeyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Certificates returns a CertificateInformer.
 func (v *version) Certificates() CertificateInformer {
 	return &certificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// DHParams returns a DHParamInformer.
+func (v *version) DHParams() DHParamInformer {
+	return &dHParamInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DockerConfigs returns a DockerConfigInformer.
+func (v *version) DockerConfigs() DockerConfigInformer {
+	return &dockerConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// EncryptionKeyrings returns a EncryptionKeyringInformer.
+func (v *version) EncryptionKeyrings() EncryptionKeyringInformer {
+	return &encryptionKeyringInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Htpasswds returns a HtpasswdInformer.
+func (v *version) Htpasswds() HtpasswdInformer {
+	return &htpasswdInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// JSONWebKeySets returns a JSONWebKeySetInformer.
+func (v *version) JSONWebKeySets() JSONWebKeySetInformer {
+	return &jSONWebKeySetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// JSONWebTokens returns a JSONWebTokenInformer.
+func (v *version) JSONWebTokens() JSONWebTokenInformer {
+	return &jSONWebTokenInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// KeyPairs returns a KeyPairInformer.
+func (v *version) KeyPairs() KeyPairInformer {
+	return &keyPairInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// OpenPGPKeys returns a OpenPGPKeyInformer.
+func (v *version) OpenPGPKeys() OpenPGPKeyInformer {
+	return &openPGPKeyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Passwords returns a PasswordInformer.
 func (v *version) Passwords() PasswordInformer {
 	return &passwordInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -44,7 +121,42 @@ func (v *version) RSAKeys() RSAKeyInformer {
 	return &rSAKeyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RandomTokens returns a RandomTokenInformer.
+func (v *version) RandomTokens() RandomTokenInformer {
+	return &randomTokenInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SSHCertificates returns a SSHCertificateInformer.
+func (v *version) SSHCertificates() SSHCertificateInformer {
+	return &sSHCertificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // SSHKeys returns a SSHKeyInformer.
 func (v *version) SSHKeys() SSHKeyInformer {
 	return &sSHKeyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// ServiceAccountKubeconfigs returns a ServiceAccountKubeconfigInformer.
+func (v *version) ServiceAccountKubeconfigs() ServiceAccountKubeconfigInformer {
+	return &serviceAccountKubeconfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TLSPairs returns a TLSPairInformer.
+func (v *version) TLSPairs() TLSPairInformer {
+	return &tLSPairInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TOTPSeeds returns a TOTPSeedInformer.
+func (v *version) TOTPSeeds() TOTPSeedInformer {
+	return &tOTPSeedInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// WireGuardConfigs returns a WireGuardConfigInformer.
+func (v *version) WireGuardConfigs() WireGuardConfigInformer {
+	return &wireGuardConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// WireGuardKeys returns a WireGuardKeyInformer.
+func (v *version) WireGuardKeys() WireGuardKeyInformer {
+	return &wireGuardKeyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AgeKeyLister helps list AgeKeys.
+// All objects returned here must be treated as read-only.
+type AgeKeyLister interface {
+	// List lists all AgeKeys in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.AgeKey, err error)
+	// AgeKeys returns an object that can list and get AgeKeys.
+	AgeKeys(namespace string) AgeKeyNamespaceLister
+	AgeKeyListerExpansion
+}
+
+// ageKeyLister implements the AgeKeyLister interface.
+type ageKeyLister struct {
+	indexer cache.Indexer
+}
+
+// NewAgeKeyLister returns a new AgeKeyLister.
+func NewAgeKeyLister(indexer cache.Indexer) AgeKeyLister {
+	return &ageKeyLister{indexer: indexer}
+}
+
+// List lists all AgeKeys in the indexer.
+func (s *ageKeyLister) List(selector labels.Selector) (ret []*v1alpha1.AgeKey, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.AgeKey))
+	})
+	return ret, err
+}
+
+// AgeKeys returns an object that can list and get AgeKeys.
+func (s *ageKeyLister) AgeKeys(namespace string) AgeKeyNamespaceLister {
+	return ageKeyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// AgeKeyNamespaceLister helps list and get AgeKeys.
+// All objects returned here must be treated as read-only.
+type AgeKeyNamespaceLister interface {
+	// List lists all AgeKeys in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.AgeKey, err error)
+	// Get retrieves the AgeKey from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.AgeKey, error)
+	AgeKeyNamespaceListerExpansion
+}
+
+// ageKeyNamespaceLister implements the AgeKeyNamespaceLister
+// interface.
+type ageKeyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all AgeKeys in the indexer for a given namespace.
+func (s ageKeyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.AgeKey, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.AgeKey))
+	})
+	return ret, err
+}
+
+// Get retrieves the AgeKey from the indexer for a given namespace and name.
+func (s ageKeyNamespaceLister) Get(name string) (*v1alpha1.AgeKey, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("agekey"), name)
+	}
+	return obj.(*v1alpha1.AgeKey), nil
+}
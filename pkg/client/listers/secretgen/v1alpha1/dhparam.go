@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DHParamLister helps list DHParams.
+// All objects returned here must be treated as read-only.
+type DHParamLister interface {
+	// List lists all DHParams in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DHParam, err error)
+	// DHParams returns an object that can list and get DHParams.
+	DHParams(namespace string) DHParamNamespaceLister
+	DHParamListerExpansion
+}
+
+// dHParamLister implements the DHParamLister interface.
+type dHParamLister struct {
+	indexer cache.Indexer
+}
+
+// NewDHParamLister returns a new DHParamLister.
+func NewDHParamLister(indexer cache.Indexer) DHParamLister {
+	return &dHParamLister{indexer: indexer}
+}
+
+// List lists all DHParams in the indexer.
+func (s *dHParamLister) List(selector labels.Selector) (ret []*v1alpha1.DHParam, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DHParam))
+	})
+	return ret, err
+}
+
+// DHParams returns an object that can list and get DHParams.
+func (s *dHParamLister) DHParams(namespace string) DHParamNamespaceLister {
+	return dHParamNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DHParamNamespaceLister helps list and get DHParams.
+// All objects returned here must be treated as read-only.
+type DHParamNamespaceLister interface {
+	// List lists all DHParams in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DHParam, err error)
+	// Get retrieves the DHParam from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.DHParam, error)
+	DHParamNamespaceListerExpansion
+}
+
+// dHParamNamespaceLister implements the DHParamNamespaceLister
+// interface.
+type dHParamNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DHParams in the indexer for a given namespace.
+func (s dHParamNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.DHParam, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DHParam))
+	})
+	return ret, err
+}
+
+// Get retrieves the DHParam from the indexer for a given namespace and name.
+func (s dHParamNamespaceLister) Get(name string) (*v1alpha1.DHParam, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("dhparam"), name)
+	}
+	return obj.(*v1alpha1.DHParam), nil
+}
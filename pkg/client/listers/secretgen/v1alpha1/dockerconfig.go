@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DockerConfigLister helps list DockerConfigs.
+// All objects returned here must be treated as read-only.
+type DockerConfigLister interface {
+	// List lists all DockerConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DockerConfig, err error)
+	// DockerConfigs returns an object that can list and get DockerConfigs.
+	DockerConfigs(namespace string) DockerConfigNamespaceLister
+	DockerConfigListerExpansion
+}
+
+// dockerConfigLister implements the DockerConfigLister interface.
+type dockerConfigLister struct {
+	indexer cache.Indexer
+}
+
+// NewDockerConfigLister returns a new DockerConfigLister.
+func NewDockerConfigLister(indexer cache.Indexer) DockerConfigLister {
+	return &dockerConfigLister{indexer: indexer}
+}
+
+// List lists all DockerConfigs in the indexer.
+func (s *dockerConfigLister) List(selector labels.Selector) (ret []*v1alpha1.DockerConfig, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DockerConfig))
+	})
+	return ret, err
+}
+
+// DockerConfigs returns an object that can list and get DockerConfigs.
+func (s *dockerConfigLister) DockerConfigs(namespace string) DockerConfigNamespaceLister {
+	return dockerConfigNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DockerConfigNamespaceLister helps list and get DockerConfigs.
+// All objects returned here must be treated as read-only.
+type DockerConfigNamespaceLister interface {
+	// List lists all DockerConfigs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.DockerConfig, err error)
+	// Get retrieves the DockerConfig from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.DockerConfig, error)
+	DockerConfigNamespaceListerExpansion
+}
+
+// dockerConfigNamespaceLister implements the DockerConfigNamespaceLister
+// interface.
+type dockerConfigNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DockerConfigs in the indexer for a given namespace.
+func (s dockerConfigNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.DockerConfig, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.DockerConfig))
+	})
+	return ret, err
+}
+
+// Get retrieves the DockerConfig from the indexer for a given namespace and name.
+func (s dockerConfigNamespaceLister) Get(name string) (*v1alpha1.DockerConfig, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("dockerconfig"), name)
+	}
+	return obj.(*v1alpha1.DockerConfig), nil
+}
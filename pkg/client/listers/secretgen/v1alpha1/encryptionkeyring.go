@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// EncryptionKeyringLister helps list EncryptionKeyrings.
+// All objects returned here must be treated as read-only.
+type EncryptionKeyringLister interface {
+	// List lists all EncryptionKeyrings in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.EncryptionKeyring, err error)
+	// EncryptionKeyrings returns an object that can list and get EncryptionKeyrings.
+	EncryptionKeyrings(namespace string) EncryptionKeyringNamespaceLister
+	EncryptionKeyringListerExpansion
+}
+
+// encryptionKeyringLister implements the EncryptionKeyringLister interface.
+type encryptionKeyringLister struct {
+	indexer cache.Indexer
+}
+
+// NewEncryptionKeyringLister returns a new EncryptionKeyringLister.
+func NewEncryptionKeyringLister(indexer cache.Indexer) EncryptionKeyringLister {
+	return &encryptionKeyringLister{indexer: indexer}
+}
+
+// List lists all EncryptionKeyrings in the indexer.
+func (s *encryptionKeyringLister) List(selector labels.Selector) (ret []*v1alpha1.EncryptionKeyring, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.EncryptionKeyring))
+	})
+	return ret, err
+}
+
+// EncryptionKeyrings returns an object that can list and get EncryptionKeyrings.
+func (s *encryptionKeyringLister) EncryptionKeyrings(namespace string) EncryptionKeyringNamespaceLister {
+	return encryptionKeyringNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// EncryptionKeyringNamespaceLister helps list and get EncryptionKeyrings.
+// All objects returned here must be treated as read-only.
+type EncryptionKeyringNamespaceLister interface {
+	// List lists all EncryptionKeyrings in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.EncryptionKeyring, err error)
+	// Get retrieves the EncryptionKeyring from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.EncryptionKeyring, error)
+	EncryptionKeyringNamespaceListerExpansion
+}
+
+// encryptionKeyringNamespaceLister implements the EncryptionKeyringNamespaceLister
+// interface.
+type encryptionKeyringNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all EncryptionKeyrings in the indexer for a given namespace.
+func (s encryptionKeyringNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.EncryptionKeyring, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.EncryptionKeyring))
+	})
+	return ret, err
+}
+
+// Get retrieves the EncryptionKeyring from the indexer for a given namespace and name.
+func (s encryptionKeyringNamespaceLister) Get(name string) (*v1alpha1.EncryptionKeyring, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("encryptionkeyring"), name)
+	}
+	return obj.(*v1alpha1.EncryptionKeyring), nil
+}
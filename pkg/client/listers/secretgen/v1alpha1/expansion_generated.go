@@ -2,6 +2,14 @@
 
 package v1alpha1
 
+// AgeKeyListerExpansion allows custom methods to be added to
+// AgeKeyLister.
+type AgeKeyListerExpansion interface{}
+
+// AgeKeyNamespaceListerExpansion allows custom methods to be added to
+// AgeKeyNamespaceLister.
+type AgeKeyNamespaceListerExpansion interface{}
+
 // CertificateListerExpansion allows custom methods to be added to
 // CertificateLister.
 type CertificateListerExpansion interface{}
@@ -10,6 +18,70 @@ type CertificateListerExpansion interface{}
 // CertificateNamespaceLister.
 type CertificateNamespaceListerExpansion interface{}
 
+// DHParamListerExpansion allows custom methods to be added to
+// DHParamLister.
+type DHParamListerExpansion interface{}
+
+// DHParamNamespaceListerExpansion allows custom methods to be added to
+// DHParamNamespaceLister.
+type DHParamNamespaceListerExpansion interface{}
+
+// DockerConfigListerExpansion allows custom methods to be added to
+// DockerConfigLister.
+type DockerConfigListerExpansion interface{}
+
+// DockerConfigNamespaceListerExpansion allows custom methods to be added to
+// DockerConfigNamespaceLister.
+type DockerConfigNamespaceListerExpansion interface{}
+
+// EncryptionKeyringListerExpansion allows custom methods to be added to
+// EncryptionKeyringLister.
+type EncryptionKeyringListerExpansion interface{}
+
+// EncryptionKeyringNamespaceListerExpansion allows custom methods to be added to
+// EncryptionKeyringNamespaceLister.
+type EncryptionKeyringNamespaceListerExpansion interface{}
+
+// HtpasswdListerExpansion allows custom methods to be added to
+// HtpasswdLister.
+type HtpasswdListerExpansion interface{}
+
+// HtpasswdNamespaceListerExpansion allows custom methods to be added to
+// HtpasswdNamespaceLister.
+type HtpasswdNamespaceListerExpansion interface{}
+
+// JSONWebKeySetListerExpansion allows custom methods to be added to
+// JSONWebKeySetLister.
+type JSONWebKeySetListerExpansion interface{}
+
+// JSONWebKeySetNamespaceListerExpansion allows custom methods to be added to
+// JSONWebKeySetNamespaceLister.
+type JSONWebKeySetNamespaceListerExpansion interface{}
+
+// JSONWebTokenListerExpansion allows custom methods to be added to
+// JSONWebTokenLister.
+type JSONWebTokenListerExpansion interface{}
+
+// JSONWebTokenNamespaceListerExpansion allows custom methods to be added to
+// JSONWebTokenNamespaceLister.
+type JSONWebTokenNamespaceListerExpansion interface{}
+
+// KeyPairListerExpansion allows custom methods to be added to
+// KeyPairLister.
+type KeyPairListerExpansion interface{}
+
+// KeyPairNamespaceListerExpansion allows custom methods to be added to
+// KeyPairNamespaceLister.
+type KeyPairNamespaceListerExpansion interface{}
+
+// OpenPGPKeyListerExpansion allows custom methods to be added to
+// OpenPGPKeyLister.
+type OpenPGPKeyListerExpansion interface{}
+
+// OpenPGPKeyNamespaceListerExpansion allows custom methods to be added to
+// OpenPGPKeyNamespaceLister.
+type OpenPGPKeyNamespaceListerExpansion interface{}
+
 // PasswordListerExpansion allows custom methods to be added to
 // PasswordLister.
 type PasswordListerExpansion interface{}
@@ -26,6 +98,22 @@ type RSAKeyListerExpansion interface{}
 // RSAKeyNamespaceLister.
 type RSAKeyNamespaceListerExpansion interface{}
 
+// RandomTokenListerExpansion allows custom methods to be added to
+// RandomTokenLister.
+type RandomTokenListerExpansion interface{}
+
+// RandomTokenNamespaceListerExpansion allows custom methods to be added to
+// RandomTokenNamespaceLister.
+type RandomTokenNamespaceListerExpansion interface{}
+
+// SSHCertificateListerExpansion allows custom methods to be added to
+// SSHCertificateLister.
+type SSHCertificateListerExpansion interface{}
+
+// SSHCertificateNamespaceListerExpansion allows custom methods to be added to
+// SSHCertificateNamespaceLister.
+type SSHCertificateNamespaceListerExpansion interface{}
+
 // SSHKeyListerExpansion allows custom methods to be added to
 // SSHKeyLister.
 type SSHKeyListerExpansion interface{}
@@ -33,3 +121,43 @@ type SSHKeyListerExpansion interface{}
 // SSHKeyNamespaceListerExpansion allows custom methods to be added to
 // SSHKeyNamespaceLister.
 type SSHKeyNamespaceListerExpansion interface{}
+
+// ServiceAccountKubeconfigListerExpansion allows custom methods to be added to
+// ServiceAccountKubeconfigLister.
+type ServiceAccountKubeconfigListerExpansion interface{}
+
+// ServiceAccountKubeconfigNamespaceListerExpansion allows custom methods to be added to
+// ServiceAccountKubeconfigNamespaceLister.
+type ServiceAccountKubeconfigNamespaceListerExpansion interface{}
+
+// TLSPairListerExpansion allows custom methods to be added to
+// TLSPairLister.
+type TLSPairListerExpansion interface{}
+
+// TLSPairNamespaceListerExpansion allows custom methods to be added to
+// TLSPairNamespaceLister.
+type TLSPairNamespaceListerExpansion interface{}
+
+// TOTPSeedListerExpansion allows custom methods to be added to
+// TOTPSeedLister.
+type TOTPSeedListerExpansion interface{}
+
+// TOTPSeedNamespaceListerExpansion allows custom methods to be added to
+// TOTPSeedNamespaceLister.
+type TOTPSeedNamespaceListerExpansion interface{}
+
+// WireGuardConfigListerExpansion allows custom methods to be added to
+// WireGuardConfigLister.
+type WireGuardConfigListerExpansion interface{}
+
+// WireGuardConfigNamespaceListerExpansion allows custom methods to be added to
+// WireGuardConfigNamespaceLister.
+type WireGuardConfigNamespaceListerExpansion interface{}
+
+// WireGuardKeyListerExpansion allows custom methods to be added to
+// WireGuardKeyLister.
+type WireGuardKeyListerExpansion interface{}
+
+// WireGuardKeyNamespaceListerExpansion allows custom methods to be added to
+// WireGuardKeyNamespaceLister.
+type WireGuardKeyNamespaceListerExpansion interface{}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// HtpasswdLister helps list Htpasswds.
+// All objects returned here must be treated as read-only.
+type HtpasswdLister interface {
+	// List lists all Htpasswds in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Htpasswd, err error)
+	// Htpasswds returns an object that can list and get Htpasswds.
+	Htpasswds(namespace string) HtpasswdNamespaceLister
+	HtpasswdListerExpansion
+}
+
+// htpasswdLister implements the HtpasswdLister interface.
+type htpasswdLister struct {
+	indexer cache.Indexer
+}
+
+// NewHtpasswdLister returns a new HtpasswdLister.
+func NewHtpasswdLister(indexer cache.Indexer) HtpasswdLister {
+	return &htpasswdLister{indexer: indexer}
+}
+
+// List lists all Htpasswds in the indexer.
+func (s *htpasswdLister) List(selector labels.Selector) (ret []*v1alpha1.Htpasswd, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Htpasswd))
+	})
+	return ret, err
+}
+
+// Htpasswds returns an object that can list and get Htpasswds.
+func (s *htpasswdLister) Htpasswds(namespace string) HtpasswdNamespaceLister {
+	return htpasswdNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// HtpasswdNamespaceLister helps list and get Htpasswds.
+// All objects returned here must be treated as read-only.
+type HtpasswdNamespaceLister interface {
+	// List lists all Htpasswds in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Htpasswd, err error)
+	// Get retrieves the Htpasswd from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.Htpasswd, error)
+	HtpasswdNamespaceListerExpansion
+}
+
+// htpasswdNamespaceLister implements the HtpasswdNamespaceLister
+// interface.
+type htpasswdNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Htpasswds in the indexer for a given namespace.
+func (s htpasswdNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.Htpasswd, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Htpasswd))
+	})
+	return ret, err
+}
+
+// Get retrieves the Htpasswd from the indexer for a given namespace and name.
+func (s htpasswdNamespaceLister) Get(name string) (*v1alpha1.Htpasswd, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("htpasswd"), name)
+	}
+	return obj.(*v1alpha1.Htpasswd), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// JSONWebKeySetLister helps list JSONWebKeySets.
+// All objects returned here must be treated as read-only.
+type JSONWebKeySetLister interface {
+	// List lists all JSONWebKeySets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JSONWebKeySet, err error)
+	// JSONWebKeySets returns an object that can list and get JSONWebKeySets.
+	JSONWebKeySets(namespace string) JSONWebKeySetNamespaceLister
+	JSONWebKeySetListerExpansion
+}
+
+// jSONWebKeySetLister implements the JSONWebKeySetLister interface.
+type jSONWebKeySetLister struct {
+	indexer cache.Indexer
+}
+
+// NewJSONWebKeySetLister returns a new JSONWebKeySetLister.
+func NewJSONWebKeySetLister(indexer cache.Indexer) JSONWebKeySetLister {
+	return &jSONWebKeySetLister{indexer: indexer}
+}
+
+// List lists all JSONWebKeySets in the indexer.
+func (s *jSONWebKeySetLister) List(selector labels.Selector) (ret []*v1alpha1.JSONWebKeySet, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JSONWebKeySet))
+	})
+	return ret, err
+}
+
+// JSONWebKeySets returns an object that can list and get JSONWebKeySets.
+func (s *jSONWebKeySetLister) JSONWebKeySets(namespace string) JSONWebKeySetNamespaceLister {
+	return jSONWebKeySetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// JSONWebKeySetNamespaceLister helps list and get JSONWebKeySets.
+// All objects returned here must be treated as read-only.
+type JSONWebKeySetNamespaceLister interface {
+	// List lists all JSONWebKeySets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JSONWebKeySet, err error)
+	// Get retrieves the JSONWebKeySet from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.JSONWebKeySet, error)
+	JSONWebKeySetNamespaceListerExpansion
+}
+
+// jSONWebKeySetNamespaceLister implements the JSONWebKeySetNamespaceLister
+// interface.
+type jSONWebKeySetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all JSONWebKeySets in the indexer for a given namespace.
+func (s jSONWebKeySetNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.JSONWebKeySet, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JSONWebKeySet))
+	})
+	return ret, err
+}
+
+// Get retrieves the JSONWebKeySet from the indexer for a given namespace and name.
+func (s jSONWebKeySetNamespaceLister) Get(name string) (*v1alpha1.JSONWebKeySet, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("jsonwebkeyset"), name)
+	}
+	return obj.(*v1alpha1.JSONWebKeySet), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// JSONWebTokenLister helps list JSONWebTokens.
+// All objects returned here must be treated as read-only.
+type JSONWebTokenLister interface {
+	// List lists all JSONWebTokens in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JSONWebToken, err error)
+	// JSONWebTokens returns an object that can list and get JSONWebTokens.
+	JSONWebTokens(namespace string) JSONWebTokenNamespaceLister
+	JSONWebTokenListerExpansion
+}
+
+// jSONWebTokenLister implements the JSONWebTokenLister interface.
+type jSONWebTokenLister struct {
+	indexer cache.Indexer
+}
+
+// NewJSONWebTokenLister returns a new JSONWebTokenLister.
+func NewJSONWebTokenLister(indexer cache.Indexer) JSONWebTokenLister {
+	return &jSONWebTokenLister{indexer: indexer}
+}
+
+// List lists all JSONWebTokens in the indexer.
+func (s *jSONWebTokenLister) List(selector labels.Selector) (ret []*v1alpha1.JSONWebToken, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JSONWebToken))
+	})
+	return ret, err
+}
+
+// JSONWebTokens returns an object that can list and get JSONWebTokens.
+func (s *jSONWebTokenLister) JSONWebTokens(namespace string) JSONWebTokenNamespaceLister {
+	return jSONWebTokenNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// JSONWebTokenNamespaceLister helps list and get JSONWebTokens.
+// All objects returned here must be treated as read-only.
+type JSONWebTokenNamespaceLister interface {
+	// List lists all JSONWebTokens in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.JSONWebToken, err error)
+	// Get retrieves the JSONWebToken from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.JSONWebToken, error)
+	JSONWebTokenNamespaceListerExpansion
+}
+
+// jSONWebTokenNamespaceLister implements the JSONWebTokenNamespaceLister
+// interface.
+type jSONWebTokenNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all JSONWebTokens in the indexer for a given namespace.
+func (s jSONWebTokenNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.JSONWebToken, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.JSONWebToken))
+	})
+	return ret, err
+}
+
+// Get retrieves the JSONWebToken from the indexer for a given namespace and name.
+func (s jSONWebTokenNamespaceLister) Get(name string) (*v1alpha1.JSONWebToken, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("jsonwebtoken"), name)
+	}
+	return obj.(*v1alpha1.JSONWebToken), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// OpenPGPKeyLister helps list OpenPGPKeys.
+// All objects returned here must be treated as read-only.
+type OpenPGPKeyLister interface {
+	// List lists all OpenPGPKeys in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.OpenPGPKey, err error)
+	// OpenPGPKeys returns an object that can list and get OpenPGPKeys.
+	OpenPGPKeys(namespace string) OpenPGPKeyNamespaceLister
+	OpenPGPKeyListerExpansion
+}
+
+// openPGPKeyLister implements the OpenPGPKeyLister interface.
+type openPGPKeyLister struct {
+	indexer cache.Indexer
+}
+
+// NewOpenPGPKeyLister returns a new OpenPGPKeyLister.
+func NewOpenPGPKeyLister(indexer cache.Indexer) OpenPGPKeyLister {
+	return &openPGPKeyLister{indexer: indexer}
+}
+
+// List lists all OpenPGPKeys in the indexer.
+func (s *openPGPKeyLister) List(selector labels.Selector) (ret []*v1alpha1.OpenPGPKey, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.OpenPGPKey))
+	})
+	return ret, err
+}
+
+// OpenPGPKeys returns an object that can list and get OpenPGPKeys.
+func (s *openPGPKeyLister) OpenPGPKeys(namespace string) OpenPGPKeyNamespaceLister {
+	return openPGPKeyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// OpenPGPKeyNamespaceLister helps list and get OpenPGPKeys.
+// All objects returned here must be treated as read-only.
+type OpenPGPKeyNamespaceLister interface {
+	// List lists all OpenPGPKeys in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.OpenPGPKey, err error)
+	// Get retrieves the OpenPGPKey from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.OpenPGPKey, error)
+	OpenPGPKeyNamespaceListerExpansion
+}
+
+// openPGPKeyNamespaceLister implements the OpenPGPKeyNamespaceLister
+// interface.
+type openPGPKeyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all OpenPGPKeys in the indexer for a given namespace.
+func (s openPGPKeyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.OpenPGPKey, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.OpenPGPKey))
+	})
+	return ret, err
+}
+
+// Get retrieves the OpenPGPKey from the indexer for a given namespace and name.
+func (s openPGPKeyNamespaceLister) Get(name string) (*v1alpha1.OpenPGPKey, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("openpgpkey"), name)
+	}
+	return obj.(*v1alpha1.OpenPGPKey), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// RandomTokenLister helps list RandomTokens.
+// All objects returned here must be treated as read-only.
+type RandomTokenLister interface {
+	// List lists all RandomTokens in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RandomToken, err error)
+	// RandomTokens returns an object that can list and get RandomTokens.
+	RandomTokens(namespace string) RandomTokenNamespaceLister
+	RandomTokenListerExpansion
+}
+
+// randomTokenLister implements the RandomTokenLister interface.
+type randomTokenLister struct {
+	indexer cache.Indexer
+}
+
+// NewRandomTokenLister returns a new RandomTokenLister.
+func NewRandomTokenLister(indexer cache.Indexer) RandomTokenLister {
+	return &randomTokenLister{indexer: indexer}
+}
+
+// List lists all RandomTokens in the indexer.
+func (s *randomTokenLister) List(selector labels.Selector) (ret []*v1alpha1.RandomToken, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RandomToken))
+	})
+	return ret, err
+}
+
+// RandomTokens returns an object that can list and get RandomTokens.
+func (s *randomTokenLister) RandomTokens(namespace string) RandomTokenNamespaceLister {
+	return randomTokenNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// RandomTokenNamespaceLister helps list and get RandomTokens.
+// All objects returned here must be treated as read-only.
+type RandomTokenNamespaceLister interface {
+	// List lists all RandomTokens in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.RandomToken, err error)
+	// Get retrieves the RandomToken from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.RandomToken, error)
+	RandomTokenNamespaceListerExpansion
+}
+
+// randomTokenNamespaceLister implements the RandomTokenNamespaceLister
+// interface.
+type randomTokenNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all RandomTokens in the indexer for a given namespace.
+func (s randomTokenNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.RandomToken, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.RandomToken))
+	})
+	return ret, err
+}
+
+// Get retrieves the RandomToken from the indexer for a given namespace and name.
+func (s randomTokenNamespaceLister) Get(name string) (*v1alpha1.RandomToken, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("randomtoken"), name)
+	}
+	return obj.(*v1alpha1.RandomToken), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ServiceAccountKubeconfigLister helps list ServiceAccountKubeconfigs.
+// All objects returned here must be treated as read-only.
+type ServiceAccountKubeconfigLister interface {
+	// List lists all ServiceAccountKubeconfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ServiceAccountKubeconfig, err error)
+	// ServiceAccountKubeconfigs returns an object that can list and get ServiceAccountKubeconfigs.
+	ServiceAccountKubeconfigs(namespace string) ServiceAccountKubeconfigNamespaceLister
+	ServiceAccountKubeconfigListerExpansion
+}
+
+// serviceAccountKubeconfigLister implements the ServiceAccountKubeconfigLister interface.
+type serviceAccountKubeconfigLister struct {
+	indexer cache.Indexer
+}
+
+// NewServiceAccountKubeconfigLister returns a new ServiceAccountKubeconfigLister.
+func NewServiceAccountKubeconfigLister(indexer cache.Indexer) ServiceAccountKubeconfigLister {
+	return &serviceAccountKubeconfigLister{indexer: indexer}
+}
+
+// List lists all ServiceAccountKubeconfigs in the indexer.
+func (s *serviceAccountKubeconfigLister) List(selector labels.Selector) (ret []*v1alpha1.ServiceAccountKubeconfig, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ServiceAccountKubeconfig))
+	})
+	return ret, err
+}
+
+// ServiceAccountKubeconfigs returns an object that can list and get ServiceAccountKubeconfigs.
+func (s *serviceAccountKubeconfigLister) ServiceAccountKubeconfigs(namespace string) ServiceAccountKubeconfigNamespaceLister {
+	return serviceAccountKubeconfigNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ServiceAccountKubeconfigNamespaceLister helps list and get ServiceAccountKubeconfigs.
+// All objects returned here must be treated as read-only.
+type ServiceAccountKubeconfigNamespaceLister interface {
+	// List lists all ServiceAccountKubeconfigs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.ServiceAccountKubeconfig, err error)
+	// Get retrieves the ServiceAccountKubeconfig from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.ServiceAccountKubeconfig, error)
+	ServiceAccountKubeconfigNamespaceListerExpansion
+}
+
+// serviceAccountKubeconfigNamespaceLister implements the ServiceAccountKubeconfigNamespaceLister
+// interface.
+type serviceAccountKubeconfigNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ServiceAccountKubeconfigs in the indexer for a given namespace.
+func (s serviceAccountKubeconfigNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.ServiceAccountKubeconfig, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.ServiceAccountKubeconfig))
+	})
+	return ret, err
+}
+
+// Get retrieves the ServiceAccountKubeconfig from the indexer for a given namespace and name.
+func (s serviceAccountKubeconfigNamespaceLister) Get(name string) (*v1alpha1.ServiceAccountKubeconfig, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("serviceaccountkubeconfig"), name)
+	}
+	return obj.(*v1alpha1.ServiceAccountKubeconfig), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SSHCertificateLister helps list SSHCertificates.
+// All objects returned here must be treated as read-only.
+type SSHCertificateLister interface {
+	// List lists all SSHCertificates in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.SSHCertificate, err error)
+	// SSHCertificates returns an object that can list and get SSHCertificates.
+	SSHCertificates(namespace string) SSHCertificateNamespaceLister
+	SSHCertificateListerExpansion
+}
+
+// sSHCertificateLister implements the SSHCertificateLister interface.
+type sSHCertificateLister struct {
+	indexer cache.Indexer
+}
+
+// NewSSHCertificateLister returns a new SSHCertificateLister.
+func NewSSHCertificateLister(indexer cache.Indexer) SSHCertificateLister {
+	return &sSHCertificateLister{indexer: indexer}
+}
+
+// List lists all SSHCertificates in the indexer.
+func (s *sSHCertificateLister) List(selector labels.Selector) (ret []*v1alpha1.SSHCertificate, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.SSHCertificate))
+	})
+	return ret, err
+}
+
+// SSHCertificates returns an object that can list and get SSHCertificates.
+func (s *sSHCertificateLister) SSHCertificates(namespace string) SSHCertificateNamespaceLister {
+	return sSHCertificateNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// SSHCertificateNamespaceLister helps list and get SSHCertificates.
+// All objects returned here must be treated as read-only.
+type SSHCertificateNamespaceLister interface {
+	// List lists all SSHCertificates in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.SSHCertificate, err error)
+	// Get retrieves the SSHCertificate from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.SSHCertificate, error)
+	SSHCertificateNamespaceListerExpansion
+}
+
+// sSHCertificateNamespaceLister implements the SSHCertificateNamespaceLister
+// interface.
+type sSHCertificateNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all SSHCertificates in the indexer for a given namespace.
+func (s sSHCertificateNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.SSHCertificate, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.SSHCertificate))
+	})
+	return ret, err
+}
+
+// Get retrieves the SSHCertificate from the indexer for a given namespace and name.
+func (s sSHCertificateNamespaceLister) Get(name string) (*v1alpha1.SSHCertificate, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("sshcertificate"), name)
+	}
+	return obj.(*v1alpha1.SSHCertificate), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TLSPairLister helps list TLSPairs.
+// All objects returned here must be treated as read-only.
+type TLSPairLister interface {
+	// List lists all TLSPairs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TLSPair, err error)
+	// TLSPairs returns an object that can list and get TLSPairs.
+	TLSPairs(namespace string) TLSPairNamespaceLister
+	TLSPairListerExpansion
+}
+
+// tLSPairLister implements the TLSPairLister interface.
+type tLSPairLister struct {
+	indexer cache.Indexer
+}
+
+// NewTLSPairLister returns a new TLSPairLister.
+func NewTLSPairLister(indexer cache.Indexer) TLSPairLister {
+	return &tLSPairLister{indexer: indexer}
+}
+
+// List lists all TLSPairs in the indexer.
+func (s *tLSPairLister) List(selector labels.Selector) (ret []*v1alpha1.TLSPair, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TLSPair))
+	})
+	return ret, err
+}
+
+// TLSPairs returns an object that can list and get TLSPairs.
+func (s *tLSPairLister) TLSPairs(namespace string) TLSPairNamespaceLister {
+	return tLSPairNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TLSPairNamespaceLister helps list and get TLSPairs.
+// All objects returned here must be treated as read-only.
+type TLSPairNamespaceLister interface {
+	// List lists all TLSPairs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TLSPair, err error)
+	// Get retrieves the TLSPair from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.TLSPair, error)
+	TLSPairNamespaceListerExpansion
+}
+
+// tLSPairNamespaceLister implements the TLSPairNamespaceLister
+// interface.
+type tLSPairNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TLSPairs in the indexer for a given namespace.
+func (s tLSPairNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.TLSPair, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TLSPair))
+	})
+	return ret, err
+}
+
+// Get retrieves the TLSPair from the indexer for a given namespace and name.
+func (s tLSPairNamespaceLister) Get(name string) (*v1alpha1.TLSPair, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("tlspair"), name)
+	}
+	return obj.(*v1alpha1.TLSPair), nil
+}
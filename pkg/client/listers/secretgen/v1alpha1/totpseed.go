@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TOTPSeedLister helps list TOTPSeeds.
+// All objects returned here must be treated as read-only.
+type TOTPSeedLister interface {
+	// List lists all TOTPSeeds in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TOTPSeed, err error)
+	// TOTPSeeds returns an object that can list and get TOTPSeeds.
+	TOTPSeeds(namespace string) TOTPSeedNamespaceLister
+	TOTPSeedListerExpansion
+}
+
+// tOTPSeedLister implements the TOTPSeedLister interface.
+type tOTPSeedLister struct {
+	indexer cache.Indexer
+}
+
+// NewTOTPSeedLister returns a new TOTPSeedLister.
+func NewTOTPSeedLister(indexer cache.Indexer) TOTPSeedLister {
+	return &tOTPSeedLister{indexer: indexer}
+}
+
+// List lists all TOTPSeeds in the indexer.
+func (s *tOTPSeedLister) List(selector labels.Selector) (ret []*v1alpha1.TOTPSeed, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TOTPSeed))
+	})
+	return ret, err
+}
+
+// TOTPSeeds returns an object that can list and get TOTPSeeds.
+func (s *tOTPSeedLister) TOTPSeeds(namespace string) TOTPSeedNamespaceLister {
+	return tOTPSeedNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// TOTPSeedNamespaceLister helps list and get TOTPSeeds.
+// All objects returned here must be treated as read-only.
+type TOTPSeedNamespaceLister interface {
+	// List lists all TOTPSeeds in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.TOTPSeed, err error)
+	// Get retrieves the TOTPSeed from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.TOTPSeed, error)
+	TOTPSeedNamespaceListerExpansion
+}
+
+// tOTPSeedNamespaceLister implements the TOTPSeedNamespaceLister
+// interface.
+type tOTPSeedNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all TOTPSeeds in the indexer for a given namespace.
+func (s tOTPSeedNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.TOTPSeed, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.TOTPSeed))
+	})
+	return ret, err
+}
+
+// Get retrieves the TOTPSeed from the indexer for a given namespace and name.
+func (s tOTPSeedNamespaceLister) Get(name string) (*v1alpha1.TOTPSeed, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("totpseed"), name)
+	}
+	return obj.(*v1alpha1.TOTPSeed), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WireGuardConfigLister helps list WireGuardConfigs.
+// All objects returned here must be treated as read-only.
+type WireGuardConfigLister interface {
+	// List lists all WireGuardConfigs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WireGuardConfig, err error)
+	// WireGuardConfigs returns an object that can list and get WireGuardConfigs.
+	WireGuardConfigs(namespace string) WireGuardConfigNamespaceLister
+	WireGuardConfigListerExpansion
+}
+
+// wireGuardConfigLister implements the WireGuardConfigLister interface.
+type wireGuardConfigLister struct {
+	indexer cache.Indexer
+}
+
+// NewWireGuardConfigLister returns a new WireGuardConfigLister.
+func NewWireGuardConfigLister(indexer cache.Indexer) WireGuardConfigLister {
+	return &wireGuardConfigLister{indexer: indexer}
+}
+
+// List lists all WireGuardConfigs in the indexer.
+func (s *wireGuardConfigLister) List(selector labels.Selector) (ret []*v1alpha1.WireGuardConfig, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WireGuardConfig))
+	})
+	return ret, err
+}
+
+// WireGuardConfigs returns an object that can list and get WireGuardConfigs.
+func (s *wireGuardConfigLister) WireGuardConfigs(namespace string) WireGuardConfigNamespaceLister {
+	return wireGuardConfigNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WireGuardConfigNamespaceLister helps list and get WireGuardConfigs.
+// All objects returned here must be treated as read-only.
+type WireGuardConfigNamespaceLister interface {
+	// List lists all WireGuardConfigs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WireGuardConfig, err error)
+	// Get retrieves the WireGuardConfig from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.WireGuardConfig, error)
+	WireGuardConfigNamespaceListerExpansion
+}
+
+// wireGuardConfigNamespaceLister implements the WireGuardConfigNamespaceLister
+// interface.
+type wireGuardConfigNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WireGuardConfigs in the indexer for a given namespace.
+func (s wireGuardConfigNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.WireGuardConfig, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WireGuardConfig))
+	})
+	return ret, err
+}
+
+// Get retrieves the WireGuardConfig from the indexer for a given namespace and name.
+func (s wireGuardConfigNamespaceLister) Get(name string) (*v1alpha1.WireGuardConfig, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("wireguardconfig"), name)
+	}
+	return obj.(*v1alpha1.WireGuardConfig), nil
+}
@@ -0,0 +1,83 @@
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// WireGuardKeyLister helps list WireGuardKeys.
+// All objects returned here must be treated as read-only.
+type WireGuardKeyLister interface {
+	// List lists all WireGuardKeys in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WireGuardKey, err error)
+	// WireGuardKeys returns an object that can list and get WireGuardKeys.
+	WireGuardKeys(namespace string) WireGuardKeyNamespaceLister
+	WireGuardKeyListerExpansion
+}
+
+// wireGuardKeyLister implements the WireGuardKeyLister interface.
+type wireGuardKeyLister struct {
+	indexer cache.Indexer
+}
+
+// NewWireGuardKeyLister returns a new WireGuardKeyLister.
+func NewWireGuardKeyLister(indexer cache.Indexer) WireGuardKeyLister {
+	return &wireGuardKeyLister{indexer: indexer}
+}
+
+// List lists all WireGuardKeys in the indexer.
+func (s *wireGuardKeyLister) List(selector labels.Selector) (ret []*v1alpha1.WireGuardKey, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WireGuardKey))
+	})
+	return ret, err
+}
+
+// WireGuardKeys returns an object that can list and get WireGuardKeys.
+func (s *wireGuardKeyLister) WireGuardKeys(namespace string) WireGuardKeyNamespaceLister {
+	return wireGuardKeyNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// WireGuardKeyNamespaceLister helps list and get WireGuardKeys.
+// All objects returned here must be treated as read-only.
+type WireGuardKeyNamespaceLister interface {
+	// List lists all WireGuardKeys in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.WireGuardKey, err error)
+	// Get retrieves the WireGuardKey from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.WireGuardKey, error)
+	WireGuardKeyNamespaceListerExpansion
+}
+
+// wireGuardKeyNamespaceLister implements the WireGuardKeyNamespaceLister
+// interface.
+type wireGuardKeyNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all WireGuardKeys in the indexer for a given namespace.
+func (s wireGuardKeyNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.WireGuardKey, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.WireGuardKey))
+	})
+	return ret, err
+}
+
+// Get retrieves the WireGuardKey from the indexer for a given namespace and name.
+func (s wireGuardKeyNamespaceLister) Get(name string) (*v1alpha1.WireGuardKey, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("wireguardkey"), name)
+	}
+	return obj.(*v1alpha1.WireGuardKey), nil
+}
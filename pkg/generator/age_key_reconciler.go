@@ -0,0 +1,151 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"fmt"
+
+	"filippo.io/age"
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+type AgeKeyReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &AgeKeyReconciler{}
+
+func NewAgeKeyReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *AgeKeyReconciler {
+	return &AgeKeyReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *AgeKeyReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.AgeKey{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *AgeKeyReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	ageKey, err := r.sgClient.SecretgenV1alpha1().AgeKeys(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if ageKey.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		ageKey.Status.GenericStatus,
+		func(st sgv1alpha1.GenericStatus) { ageKey.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(ageKey.ObjectMeta)
+	defer r.updateStatus(ctx, ageKey)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, ageKey))
+}
+
+func (r *AgeKeyReconciler) reconcile(ctx context.Context, ageKey *sgv1alpha1.AgeKey) (reconcile.Result, error) {
+	rotation := newRotation(ageKey.ObjectMeta, ageKey.Status.LastRotation)
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(ageKey.Namespace).Get(ctx, ageKey.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.createSecret(ctx, ageKey, nil, rotation)
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() && metav1.IsControlledBy(existingSecret, ageKey) {
+		return r.createSecret(ctx, ageKey, existingSecret, rotation)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// createSecret generates new values and creates Secret
+// or replaces values of existing Secret when rotation is requested.
+func (r *AgeKeyReconciler) createSecret(ctx context.Context, ageKey *sgv1alpha1.AgeKey,
+	existingSecret *corev1.Secret, rotation rotation) (reconcile.Result, error) {
+
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Generating age identity: %s", err)
+	}
+
+	values := map[string][]byte{
+		sgv1alpha1.AgeKeySecretIdentityKey:  []byte(identity.String()),
+		sgv1alpha1.AgeKeySecretRecipientKey: []byte(identity.Recipient().String()),
+	}
+
+	secret := reconciler.NewSecret(ageKey, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.AgeKeySecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.AgeKeySecretDefaultIdentityKey:  expansion.Variable(sgv1alpha1.AgeKeySecretIdentityKey),
+			sgv1alpha1.AgeKeySecretDefaultRecipientKey: expansion.Variable(sgv1alpha1.AgeKeySecretRecipientKey),
+		},
+	}
+
+	err = secret.ApplyTemplates(defaultTemplate, ageKey.Spec.SecretTemplate)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() {
+		ageKey.Status.LastRotation = rotation.Status()
+	}
+
+	ageKey.Status.Recipient = identity.Recipient().String()
+
+	return reconcile.Result{}, nil
+}
+
+func (r *AgeKeyReconciler) updateStatus(ctx context.Context, ageKey *sgv1alpha1.AgeKey) error {
+	existingAgeKey, err := r.sgClient.SecretgenV1alpha1().AgeKeys(ageKey.Namespace).Get(ctx, ageKey.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching agekey: %s", err)
+	}
+
+	existingAgeKey.Status = ageKey.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().AgeKeys(existingAgeKey.Namespace).UpdateStatus(ctx, existingAgeKey, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating agekey status: %s", err)
+	}
+
+	return nil
+}
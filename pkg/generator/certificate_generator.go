@@ -0,0 +1,206 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"time"
+
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+)
+
+const (
+	certificateKeyBits         = 3072
+	certificateDefaultDuration = 365 // days
+)
+
+type certResult struct {
+	Certificate *x509.Certificate
+	PrivateKey  *rsa.PrivateKey
+	CA          *x509.Certificate
+}
+
+func (r certResult) CertificatePEM() string { return encodeCertificatePEM(r.Certificate) }
+func (r certResult) CAPEM() string          { return encodeCertificatePEM(r.CA) }
+
+func (r certResult) PrivateKeyPEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(r.PrivateKey)}))
+}
+
+// Values returns all encodings of generated certificate
+// that could be referenced in secret templates.
+func (r certResult) Values() (map[string][]byte, error) {
+	privateKeyPKCS8, err := encodePrivateKeyPKCS8PEM(r.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := encodePublicKeyPEM(&r.PrivateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		sgv1alpha1.CertificateSecretCertificateKey:     []byte(r.CertificatePEM()),
+		sgv1alpha1.CertificateSecretPrivateKeyKey:      []byte(r.PrivateKeyPEM()),
+		sgv1alpha1.CertificateSecretCombinedPEMKey:     []byte(r.CertificatePEM() + r.PrivateKeyPEM()),
+		sgv1alpha1.CertificateSecretCertificateDERKey:  r.Certificate.Raw,
+		sgv1alpha1.CertificateSecretPrivateKeyPKCS8Key: []byte(privateKeyPKCS8),
+		sgv1alpha1.CertificateSecretPublicKeyKey:       []byte(publicKey),
+	}, nil
+}
+
+func encodeCertificatePEM(cert *x509.Certificate) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))
+}
+
+// certGenerator issues RSA backed certificates optionally signed by a CA.
+// It mimics config-server's certificate generator while allowing
+// to control validity window of generated certificates.
+type certGenerator struct {
+	caCert *x509.Certificate
+	caKey  *rsa.PrivateKey
+
+	// capToCA shortens validity of issued certificates
+	// to fit into CA's lifetime instead of rejecting them
+	capToCA bool
+}
+
+func (g certGenerator) Generate(params certParams) (certResult, error) {
+	if g.caCert == nil && !params.IsCA {
+		return certResult{}, fmt.Errorf("Missing required CA")
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, certificateKeyBits)
+	if err != nil {
+		return certResult{}, fmt.Errorf("Generating key: %s", err)
+	}
+
+	template, err := g.template(params)
+	if err != nil {
+		return certResult{}, err
+	}
+
+	template.SubjectKeyId = g.bigIntHash(privateKey.N)
+
+	signingCert := g.caCert
+	signingKey := g.caKey
+
+	if params.IsCA {
+		template.KeyUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+
+		if g.caCert == nil {
+			signingCert = template
+			signingKey = privateKey
+		}
+	} else {
+		template.KeyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature
+
+		for _, extKeyUsage := range params.ExtKeyUsage {
+			switch extKeyUsage {
+			case "client_auth":
+				template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageClientAuth)
+			case "server_auth":
+				template.ExtKeyUsage = append(template.ExtKeyUsage, x509.ExtKeyUsageServerAuth)
+			default:
+				return certResult{}, fmt.Errorf("Unsupported extended key usage value: %s", extKeyUsage)
+			}
+		}
+		if len(template.ExtKeyUsage) == 0 {
+			template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+		}
+
+		for _, altName := range params.AlternativeNames {
+			possibleIP := net.ParseIP(altName)
+			if possibleIP == nil {
+				template.DNSNames = append(template.DNSNames, altName)
+			} else {
+				template.IPAddresses = append(template.IPAddresses, possibleIP)
+			}
+		}
+	}
+
+	template.AuthorityKeyId = signingCert.SubjectKeyId
+
+	certRaw, err := x509.CreateCertificate(rand.Reader, template, signingCert, &privateKey.PublicKey, signingKey)
+	if err != nil {
+		return certResult{}, fmt.Errorf("Generating certificate: %s", err)
+	}
+
+	cert, err := x509.ParseCertificate(certRaw)
+	if err != nil {
+		return certResult{}, fmt.Errorf("Parsing generated certificate: %s", err)
+	}
+
+	result := certResult{Certificate: cert, PrivateKey: privateKey, CA: g.caCert}
+	if g.caCert == nil {
+		result.CA = cert
+	}
+
+	return result, nil
+}
+
+func (g certGenerator) template(params certParams) (*x509.Certificate, error) {
+	serialNumberLimit := new(big.Int).Lsh(big.NewInt(1), 128)
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("Generating serial number: %s", err)
+	}
+
+	now := time.Now()
+	notBefore := now.Add(-params.NotBeforeSkew)
+
+	validity := params.Validity
+	// Legacy and default durations were never checked against CA
+	// so keep accepting them by capping to CA's lifetime
+	capToCA := g.capToCA || validity == 0
+
+	if validity == 0 {
+		days := params.Duration
+		if days == 0 {
+			days = certificateDefaultDuration
+		}
+		validity = time.Duration(days) * 24 * time.Hour
+	}
+
+	notAfter := now.Add(validity)
+
+	if g.caCert != nil && notAfter.After(g.caCert.NotAfter) {
+		if !capToCA {
+			// Retrying does not help until validity or CA is changed
+			return nil, reconciler.TerminalReconcileErr{Err: fmt.Errorf("Expected certificate validity (until %s) to not exceed "+
+				"remaining lifetime of CA certificate (until %s)",
+				notAfter.UTC().Format(time.RFC3339), g.caCert.NotAfter.UTC().Format(time.RFC3339))}
+		}
+		notAfter = g.caCert.NotAfter
+	}
+
+	return &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			Country:      []string{"USA"},
+			Organization: []string{params.Organization},
+			CommonName:   params.CommonName,
+		},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  params.IsCA,
+	}, nil
+}
+
+func (certGenerator) bigIntHash(n *big.Int) []byte {
+	h := sha1.New()
+	h.Write(n.Bytes())
+	return h.Sum(nil)
+}
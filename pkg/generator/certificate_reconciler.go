@@ -6,8 +6,8 @@ package generator
 import (
 	"context"
 	"fmt"
+	"time"
 
-	cfgtypes "github.com/cloudfoundry/config-server/types"
 	"github.com/go-logr/logr"
 	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
 	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
@@ -71,86 +71,166 @@ func (r *CertificateReconciler) Reconcile(ctx context.Context, request reconcile
 }
 
 func (r *CertificateReconciler) reconcile(ctx context.Context, cert *sgv1alpha1.Certificate) (reconcile.Result, error) {
-	params := newCertParams(cert)
+	params, err := newCertParams(cert)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
 
 	existingSecret, err := r.coreClient.CoreV1().Secrets(cert.Namespace).Get(ctx, cert.Name, metav1.GetOptions{})
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return r.createSecret(ctx, params, cert)
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, err
+		}
+		existingSecret = nil
+	}
+
+	if existingSecret != nil && !metav1.IsControlledBy(existingSecret, cert) && cert.Spec.AdoptExisting {
+		existingSecret, err = r.adoptSecret(ctx, params, cert, existingSecret)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+	}
+
+	rotation := newRotation(cert.ObjectMeta, cert.Status.LastRotation)
+
+	if existingSecret != nil {
+		if rotation.IsRequested() && metav1.IsControlledBy(existingSecret, cert) {
+			return r.rotateSecret(ctx, params, cert, existingSecret, rotation)
 		}
+		if (GenerateInputs{params}).IsChanged(existingSecret.Annotations) && metav1.IsControlledBy(existingSecret, cert) {
+			return r.updateSecret(ctx, params, cert, existingSecret)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	newSecret, err := r.newSecret(ctx, params, cert)
+	if err != nil {
 		return reconcile.Result{Requeue: true}, err
 	}
 
-	if (GenerateInputs{params}).IsChanged(existingSecret.Annotations) {
-		return r.updateSecret(params, cert, existingSecret)
+	_, err = applySecret(ctx, r.coreClient, nil, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() {
+		// Token set at creation time does not need to trigger another generation
+		cert.Status.LastRotation = rotation.Status()
 	}
 
 	return reconcile.Result{}, nil
 }
 
-func (r *CertificateReconciler) createSecret(ctx context.Context, params certParams, cert *sgv1alpha1.Certificate) (reconcile.Result, error) {
+func (r *CertificateReconciler) newSecret(ctx context.Context,
+	params certParams, cert *sgv1alpha1.Certificate) (*corev1.Secret, error) {
+
 	certResult, err := r.generate(ctx, params, cert)
 	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+		return nil, err
 	}
 
-	values := map[string][]byte{
-		sgv1alpha1.CertificateSecretCertificateKey: []byte(certResult.Certificate),
-		sgv1alpha1.CertificateSecretPrivateKeyKey:  []byte(certResult.PrivateKey),
+	values, err := certResult.Values()
+	if err != nil {
+		return nil, err
 	}
 
 	secret := reconciler.NewSecret(cert, values)
 
-	defaultTemplate := sgv1alpha1.SecretTemplate{
+	err = secret.ApplyTemplates(certificateDefaultSecretTemplate(), cert.Spec.SecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = GenerateInputs{params}.Add(newSecret.Annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSecret, nil
+}
+
+func certificateDefaultSecretTemplate() sgv1alpha1.SecretTemplate {
+	return sgv1alpha1.SecretTemplate{
 		Type: sgv1alpha1.CertificateSecretDefaultType,
 		StringData: map[string]string{
 			sgv1alpha1.CertificateSecretDefaultCertificateKey: expansion.Variable(sgv1alpha1.CertificateSecretCertificateKey),
 			sgv1alpha1.CertificateSecretDefaultPrivateKeyKey:  expansion.Variable(sgv1alpha1.CertificateSecretPrivateKeyKey),
 		},
 	}
+}
 
-	err = secret.ApplyTemplates(defaultTemplate, cert.Spec.SecretTemplate)
-	if err != nil {
-		return reconcile.Result{Requeue: true}, err
-	}
+// adoptSecret takes ownership of existing Secret keeping its certificate.
+// Current inputs are recorded so that adopted certificate is considered
+// to be generated from them.
+func (r *CertificateReconciler) adoptSecret(ctx context.Context, params certParams,
+	cert *sgv1alpha1.Certificate, existingSecret *corev1.Secret) (*corev1.Secret, error) {
 
-	newSecret := secret.AsSecret()
+	secret := existingSecret.DeepCopy()
 
-	if newSecret.Annotations == nil {
-		newSecret.Annotations = map[string]string{}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
 	}
-	err = GenerateInputs{params}.Add(newSecret.Annotations)
+	err := GenerateInputs{params}.Add(secret.Annotations)
 	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+		return nil, err
 	}
 
-	_, err = r.coreClient.CoreV1().Secrets(newSecret.Namespace).Create(ctx, newSecret, metav1.CreateOptions{})
+	return adoptSecret(ctx, r.coreClient, cert, secret,
+		secretTemplateKeys(certificateDefaultSecretTemplate(), cert.Spec.SecretTemplate))
+}
+
+// rotateSecret replaces existing certificate and private key with newly generated ones
+func (r *CertificateReconciler) rotateSecret(ctx context.Context, params certParams,
+	cert *sgv1alpha1.Certificate, existingSecret *corev1.Secret, rotation rotation) (reconcile.Result, error) {
+
+	result, err := r.updateSecret(ctx, params, cert, existingSecret)
 	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+		return result, err
 	}
 
-	return reconcile.Result{}, nil
+	cert.Status.LastRotation = rotation.Status()
+
+	return result, nil
 }
 
-func (r *CertificateReconciler) updateSecret(params certParams,
-	cert *sgv1alpha1.Certificate, secret *corev1.Secret) (reconcile.Result, error) {
+// updateSecret reissues certificate (with new private key) so that
+// it reflects changed inputs (e.g. alternative names or validity)
+func (r *CertificateReconciler) updateSecret(ctx context.Context, params certParams,
+	cert *sgv1alpha1.Certificate, existingSecret *corev1.Secret) (reconcile.Result, error) {
+
+	newSecret, err := r.newSecret(ctx, params, cert)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
 
-	// TODO implement
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
 
 	return reconcile.Result{}, nil
 }
 
+// certParams are recorded in generate inputs annotation
+// hence changing them affects detection of changed inputs.
 type certParams struct {
-	CommonName       string   `yaml:"common_name"`
-	Organization     string   `yaml:"organization"`
-	AlternativeNames []string `yaml:"alternative_names"`
-	IsCA             bool     `yaml:"is_ca"`
-	CAName           string   `yaml:"ca"`
-	ExtKeyUsage      []string `yaml:"extended_key_usage"`
-	Duration         int64    `yaml:"duration"`
+	CommonName       string
+	Organization     string
+	AlternativeNames []string
+	IsCA             bool
+	CAName           string
+	ExtKeyUsage      []string
+	Duration         int64
+	Validity         time.Duration `json:",omitempty"`
+	NotBeforeSkew    time.Duration `json:",omitempty"`
 }
 
-func newCertParams(cert *sgv1alpha1.Certificate) certParams {
+func newCertParams(cert *sgv1alpha1.Certificate) (certParams, error) {
 	params := certParams{
 		CommonName:       cert.Spec.CommonName,
 		Organization:     cert.Spec.Organization,
@@ -170,25 +250,53 @@ func newCertParams(cert *sgv1alpha1.Certificate) certParams {
 		params.CAName = "unused-but-not-empty"
 	}
 
-	return params
+	err := params.setValidity(cert.Spec.Validity, cert.Spec.NotBeforeSkew)
+	if err != nil {
+		return certParams{}, err
+	}
+
+	return params, nil
+}
+
+func (p *certParams) setValidity(validity, notBeforeSkew string) error {
+	if len(validity) > 0 {
+		dur, err := ParseDuration(validity)
+		if err != nil {
+			return fmt.Errorf("Invalid validity: %s", err)
+		}
+		if dur <= 0 {
+			return fmt.Errorf("Invalid validity: expected to be greater than zero")
+		}
+		// Validity supersedes legacy duration (in days)
+		p.Validity = dur
+		p.Duration = 0
+	}
+
+	if len(notBeforeSkew) > 0 {
+		dur, err := ParseDuration(notBeforeSkew)
+		if err != nil {
+			return fmt.Errorf("Invalid notBeforeSkew: %s", err)
+		}
+		p.NotBeforeSkew = dur
+	}
+
+	return nil
 }
 
 func (r *CertificateReconciler) generate(ctx context.Context, params certParams,
-	cert *sgv1alpha1.Certificate) (cfgtypes.CertResponse, error) {
+	cert *sgv1alpha1.Certificate) (certResult, error) {
 
 	caCertSecret, err := r.getCARefSecret(ctx, cert)
 	if err != nil {
-		return cfgtypes.CertResponse{}, err
+		return certResult{}, err
 	}
 
-	gen := cfgtypes.NewCertificateGenerator(singleCertLoader{caCertSecret})
-
-	certVal, err := gen.Generate(params)
+	gen, err := newCertGenerator(caCertSecret)
 	if err != nil {
-		return cfgtypes.CertResponse{}, err
+		return certResult{}, err
 	}
 
-	return certVal.(cfgtypes.CertResponse), nil
+	return gen.Generate(params)
 }
 
 func (r *CertificateReconciler) getCARefSecret(
@@ -20,6 +20,21 @@ type singleCertLoader struct {
 
 var _ cfgtypes.CertsLoader = singleCertLoader{}
 
+// newCertGenerator returns generator that signs certificates with CA
+// found in given secret or generator of self-signed CA when secret is nil.
+func newCertGenerator(caCertSecret *corev1.Secret) (certGenerator, error) {
+	if caCertSecret == nil {
+		return certGenerator{}, nil
+	}
+
+	caCert, caKey, err := singleCertLoader{caCertSecret}.LoadCerts("")
+	if err != nil {
+		return certGenerator{}, fmt.Errorf("Loading CA certificate: %s", err)
+	}
+
+	return certGenerator{caCert: caCert, caKey: caKey}, nil
+}
+
 func (l singleCertLoader) LoadCerts(_ string) (*x509.Certificate, *rsa.PrivateKey, error) {
 	crt, err := l.parseCertificate(string(l.caCertSecret.Data[sgv1alpha1.CertificateSecretDefaultCertificateKey]))
 	if err != nil {
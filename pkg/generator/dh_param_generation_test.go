@@ -0,0 +1,105 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDHParamGenerationWaitsForWorker(t *testing.T) {
+	workers := make(chan struct{}, 1)
+	workers <- struct{}{}
+
+	key := types.NamespacedName{Namespace: "ns", Name: "dhparam"}
+
+	gen := newDHParamGeneration(key, dhParamParams{Bits: 64, Generator: dhParamGenerator}, workers)
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, started := gen.StartedAt()
+	require.False(t, started)
+
+	<-workers
+
+	prime := waitForDHParamGeneration(t, gen)
+	require.Equal(t, 64, prime.BitLen())
+	require.True(t, prime.ProbablyPrime(20))
+
+	_, started = gen.StartedAt()
+	require.True(t, started)
+	require.Len(t, workers, 0, "Expected worker to be released")
+}
+
+func TestDHParamGenerationCancelWhileWaiting(t *testing.T) {
+	workers := make(chan struct{}, 1)
+	workers <- struct{}{}
+
+	key := types.NamespacedName{Namespace: "ns", Name: "dhparam"}
+
+	gen := newDHParamGeneration(key, dhParamParams{Bits: 4096, Generator: dhParamGenerator}, workers)
+	gen.cancel()
+
+	_, err := waitForDHParamGenerationErr(t, gen)
+	require.Equal(t, context.Canceled, err)
+
+	_, started := gen.StartedAt()
+	require.False(t, started)
+}
+
+func TestDHParamReconcilerCancelGenerations(t *testing.T) {
+	r := NewDHParamReconciler(nil, nil, logr.Discard())
+	r.workers <- struct{}{}
+	r.workers <- struct{}{}
+
+	key := types.NamespacedName{Namespace: "ns", Name: "dhparam"}
+	params := dhParamParams{Bits: 2048, Generator: dhParamGenerator}
+
+	oldGen := r.startGeneration(key, "old-uid", params)
+	newGen := r.startGeneration(key, "new-uid", params)
+	require.Same(t, newGen, r.startGeneration(key, "new-uid", params))
+
+	// Recreated DHParam cancels generation of its predecessor
+	r.cancelGenerations(key, "new-uid")
+
+	require.NotContains(t, r.generations, types.UID("old-uid"))
+	require.Contains(t, r.generations, types.UID("new-uid"))
+
+	// Changed params cancel superseded generation
+	changedGen := r.startGeneration(key, "new-uid", dhParamParams{Bits: 3072, Generator: dhParamGenerator})
+	require.NotSame(t, newGen, changedGen)
+
+	r.cancelGenerations(key, "")
+	require.Empty(t, r.generations)
+
+	for _, gen := range []*dhParamGeneration{oldGen, newGen, changedGen} {
+		_, err := waitForDHParamGenerationErr(t, gen)
+		require.Equal(t, context.Canceled, err)
+	}
+}
+
+func waitForDHParamGeneration(t *testing.T, gen *dhParamGeneration) *big.Int {
+	prime, err := waitForDHParamGenerationErr(t, gen)
+	require.NoError(t, err)
+	return prime
+}
+
+func waitForDHParamGenerationErr(t *testing.T, gen *dhParamGeneration) (*big.Int, error) {
+	for i := 0; i < 500; i++ {
+		prime, done, err := gen.Result()
+		if done {
+			return prime, err
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	t.Fatalf("Expected generation to finish")
+	return nil, nil
+}
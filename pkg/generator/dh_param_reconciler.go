@@ -0,0 +1,592 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// dhParamProgressInterval is how often status of ongoing generation is updated
+	dhParamProgressInterval = 10 * time.Second
+	// dhParamGenerator is generator (base) used with generated safe prime
+	dhParamGenerator = 2
+	// dhParamMaxConcurrentGenerations bounds number of generations running
+	// at the same time (each keeps one CPU busy); others wait for their turn
+	dhParamMaxConcurrentGenerations = 2
+)
+
+// DHParamReconciler generates Diffie-Hellman parameters. Since finding
+// large safe primes may take minutes, generation happens in the background
+// while reconciles periodically report its progress.
+type DHParamReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+
+	// Generations are keyed by UID so that recreated DHParam
+	// never picks up generation started for its predecessor
+	generationsLock sync.Mutex
+	generations     map[types.UID]*dhParamGeneration
+	workers         chan struct{}
+}
+
+var _ reconcile.Reconciler = &DHParamReconciler{}
+
+// NewDHParamReconciler constructs DHParamReconciler.
+func NewDHParamReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *DHParamReconciler {
+	return &DHParamReconciler{
+		sgClient:    sgClient,
+		coreClient:  coreClient,
+		log:         log,
+		generations: map[types.UID]*dhParamGeneration{},
+		workers:     make(chan struct{}, dhParamMaxConcurrentGenerations),
+	}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *DHParamReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch generated outputs so that they are generated again when deleted
+	for _, output := range []client.Object{&corev1.Secret{}, &corev1.ConfigMap{}} {
+		err := controller.Watch(&source.Kind{Type: output}, &handler.EnqueueRequestForOwner{
+			OwnerType: &sgv1alpha1.DHParam{}, IsController: true})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Ignore status updates since progress is reported on every reconcile
+	// (periodic progress reconciles are scheduled via RequeueAfter instead)
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.DHParam{}}, &handler.EnqueueRequestForObject{},
+		predicate.Or(predicate.GenerationChangedPredicate{}, predicate.LabelChangedPredicate{}, predicate.AnnotationChangedPredicate{}))
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *DHParamReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	dhParam, err := r.sgClient.SecretgenV1alpha1().DHParams(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			r.cancelGenerations(request.NamespacedName, "")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if dhParam.DeletionTimestamp != nil {
+		r.cancelGenerations(request.NamespacedName, "")
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          dhParam.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { dhParam.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(dhParam.ObjectMeta)
+	defer r.updateStatus(ctx, dhParam)
+
+	result, gen, err := r.reconcile(ctx, dhParam)
+	if gen != nil {
+		if _, started := gen.StartedAt(); started {
+			status.SetReconcilingProgress(fmt.Sprintf("Generating %d-bit parameters (%d candidates tested)",
+				gen.Params.Bits, gen.CandidatesTested()))
+		} else {
+			status.SetReconcilingProgress(fmt.Sprintf("Waiting for available worker to generate %d-bit parameters",
+				gen.Params.Bits))
+		}
+		return result, nil
+	}
+
+	return status.WithReconcileCompleted(result, err)
+}
+
+// dhParamParams are recorded in generate inputs annotation
+// hence changing them results in parameters being regenerated.
+type dhParamParams struct {
+	Bits      int
+	Generator int
+}
+
+// reconcile returns ongoing generation (if any) so that its progress is reported.
+func (r *DHParamReconciler) reconcile(ctx context.Context, dhParam *sgv1alpha1.DHParam) (reconcile.Result, *dhParamGeneration, error) {
+	err := dhParam.Validate()
+	if err != nil {
+		return reconcile.Result{}, nil, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	dhParamKey := types.NamespacedName{Namespace: dhParam.Namespace, Name: dhParam.Name}
+	params := dhParamParams{Bits: dhParam.PrimeBits(), Generator: dhParamGenerator}
+
+	// Generations of deleted DHParam with the same name are no longer needed
+	r.cancelGenerations(dhParamKey, dhParam.UID)
+
+	existingAnns, found, err := r.existingOutput(ctx, dhParam)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, nil, err
+	}
+
+	if found && !(GenerateInputs{params}).IsChanged(existingAnns) {
+		r.cancelGeneration(dhParam.UID)
+		dhParam.Status.GenerationStartedAt = nil
+		dhParam.Status.CandidatesTested = 0
+		return reconcile.Result{}, nil, nil
+	}
+
+	gen := r.startGeneration(dhParamKey, dhParam.UID, params)
+
+	dhParam.Status.GenerationStartedAt = nil
+	if startedAt, started := gen.StartedAt(); started {
+		dhParam.Status.GenerationStartedAt = &metav1.Time{Time: startedAt}
+	}
+	dhParam.Status.CandidatesTested = gen.CandidatesTested()
+
+	prime, done, err := gen.Result()
+	if !done {
+		return reconcile.Result{RequeueAfter: dhParamProgressInterval}, gen, nil
+	}
+
+	r.cancelGeneration(dhParam.UID)
+
+	if err != nil {
+		return reconcile.Result{Requeue: true}, nil, fmt.Errorf("Generating parameters: %s", err)
+	}
+
+	paramsPEM, err := encodeDHParamPEM(prime, dhParamGenerator)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, nil, err
+	}
+
+	err = r.saveOutput(ctx, dhParam, params, paramsPEM)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, nil, err
+	}
+
+	err = r.deleteStaleOutput(ctx, dhParam)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, nil, err
+	}
+
+	dhParam.Status.Bits = params.Bits
+	dhParam.Status.GenerationStartedAt = nil
+	dhParam.Status.CandidatesTested = 0
+
+	return reconcile.Result{}, nil, nil
+}
+
+// existingOutput returns annotations of already stored parameters.
+func (r *DHParamReconciler) existingOutput(ctx context.Context, dhParam *sgv1alpha1.DHParam) (map[string]string, bool, error) {
+	var obj metav1.Object
+	var err error
+
+	if dhParam.OutputKind() == sgv1alpha1.DHParamOutputConfigMap {
+		obj, err = r.coreClient.CoreV1().ConfigMaps(dhParam.Namespace).Get(ctx, dhParam.Name, metav1.GetOptions{})
+	} else {
+		obj, err = r.coreClient.CoreV1().Secrets(dhParam.Namespace).Get(ctx, dhParam.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if !metav1.IsControlledBy(obj, dhParam) {
+		return nil, false, fmt.Errorf("Expected %s '%s' to be controlled by DHParam '%s'",
+			dhParam.OutputKind(), obj.GetName(), dhParam.Name)
+	}
+
+	return obj.GetAnnotations(), true, nil
+}
+
+func (r *DHParamReconciler) saveOutput(ctx context.Context, dhParam *sgv1alpha1.DHParam,
+	params dhParamParams, paramsPEM string) error {
+
+	if dhParam.OutputKind() == sgv1alpha1.DHParamOutputConfigMap {
+		return r.saveConfigMap(ctx, dhParam, params, paramsPEM)
+	}
+	return r.saveSecret(ctx, dhParam, params, paramsPEM)
+}
+
+// deleteStaleOutput deletes parameters stored in object of kind
+// other than current output kind (i.e. after output kind changed).
+func (r *DHParamReconciler) deleteStaleOutput(ctx context.Context, dhParam *sgv1alpha1.DHParam) error {
+	var obj metav1.Object
+	var err error
+
+	if dhParam.OutputKind() == sgv1alpha1.DHParamOutputConfigMap {
+		obj, err = r.coreClient.CoreV1().Secrets(dhParam.Namespace).Get(ctx, dhParam.Name, metav1.GetOptions{})
+	} else {
+		obj, err = r.coreClient.CoreV1().ConfigMaps(dhParam.Namespace).Get(ctx, dhParam.Name, metav1.GetOptions{})
+	}
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("Getting previous output: %s", err)
+	}
+
+	// Objects not created by DHParam are left as is
+	if !metav1.IsControlledBy(obj, dhParam) {
+		return nil
+	}
+
+	if dhParam.OutputKind() == sgv1alpha1.DHParamOutputConfigMap {
+		err = r.coreClient.CoreV1().Secrets(dhParam.Namespace).Delete(ctx, dhParam.Name, metav1.DeleteOptions{})
+	} else {
+		err = r.coreClient.CoreV1().ConfigMaps(dhParam.Namespace).Delete(ctx, dhParam.Name, metav1.DeleteOptions{})
+	}
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("Deleting previous output: %s", err)
+	}
+
+	return nil
+}
+
+func (r *DHParamReconciler) saveSecret(ctx context.Context, dhParam *sgv1alpha1.DHParam,
+	params dhParamParams, paramsPEM string) error {
+
+	values := map[string][]byte{
+		sgv1alpha1.DHParamSecretDHParamKey: []byte(paramsPEM),
+	}
+
+	secret := reconciler.NewSecret(dhParam, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.DHParamSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.DHParamDefaultKey: expansion.Variable(sgv1alpha1.DHParamSecretDHParamKey),
+		},
+	}
+
+	err := secret.ApplyTemplates(defaultTemplate, dhParam.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = GenerateInputs{params}.Add(newSecret.Annotations)
+	if err != nil {
+		return err
+	}
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(dhParam.Namespace).Get(ctx, dhParam.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting secret: %s", err)
+		}
+		_, err = applySecret(ctx, r.coreClient, nil, newSecret)
+		return err
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *DHParamReconciler) saveConfigMap(ctx context.Context, dhParam *sgv1alpha1.DHParam,
+	params dhParamParams, paramsPEM string) error {
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        dhParam.Name,
+			Namespace:   dhParam.Namespace,
+			Labels:      dhParam.Labels,
+			Annotations: map[string]string{},
+		},
+		Data: map[string]string{
+			sgv1alpha1.DHParamDefaultKey: paramsPEM,
+		},
+	}
+
+	err := GenerateInputs{params}.Add(configMap.Annotations)
+	if err != nil {
+		return err
+	}
+
+	err = controllerutil.SetControllerReference(dhParam, configMap, scheme.Scheme)
+	if err != nil {
+		return fmt.Errorf("Setting controller reference: %s", err)
+	}
+
+	existingConfigMap, err := r.coreClient.CoreV1().ConfigMaps(dhParam.Namespace).Get(ctx, dhParam.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting configmap: %s", err)
+		}
+		_, err = r.coreClient.CoreV1().ConfigMaps(configMap.Namespace).Create(ctx, configMap, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("Creating configmap: %s", err)
+		}
+		return nil
+	}
+
+	existingConfigMap.Labels = configMap.Labels
+	existingConfigMap.Annotations = configMap.Annotations
+	existingConfigMap.Data = configMap.Data
+
+	_, err = r.coreClient.CoreV1().ConfigMaps(existingConfigMap.Namespace).Update(ctx, existingConfigMap, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating configmap: %s", err)
+	}
+
+	return nil
+}
+
+func (r *DHParamReconciler) updateStatus(ctx context.Context, dhParam *sgv1alpha1.DHParam) error {
+	existingDHParam, err := r.sgClient.SecretgenV1alpha1().DHParams(dhParam.Namespace).Get(ctx, dhParam.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching dhparam: %s", err)
+	}
+
+	existingDHParam.Status = dhParam.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().DHParams(existingDHParam.Namespace).UpdateStatus(ctx, existingDHParam, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating dhparam status: %s", err)
+	}
+
+	return nil
+}
+
+// startGeneration returns ongoing generation for given parameters
+// or starts new one (cancelling generation for outdated parameters).
+func (r *DHParamReconciler) startGeneration(key types.NamespacedName,
+	uid types.UID, params dhParamParams) *dhParamGeneration {
+
+	r.generationsLock.Lock()
+	defer r.generationsLock.Unlock()
+
+	if gen, found := r.generations[uid]; found {
+		if gen.Params == params {
+			return gen
+		}
+		gen.cancel()
+	}
+
+	gen := newDHParamGeneration(key, params, r.workers)
+	r.generations[uid] = gen
+
+	return gen
+}
+
+func (r *DHParamReconciler) cancelGeneration(uid types.UID) {
+	r.generationsLock.Lock()
+	defer r.generationsLock.Unlock()
+
+	if gen, found := r.generations[uid]; found {
+		gen.cancel()
+		delete(r.generations, uid)
+	}
+}
+
+// cancelGenerations cancels generations started for DHParam with given
+// name except for generation of DHParam with given UID (if any).
+func (r *DHParamReconciler) cancelGenerations(key types.NamespacedName, exceptUID types.UID) {
+	r.generationsLock.Lock()
+	defer r.generationsLock.Unlock()
+
+	for uid, gen := range r.generations {
+		if gen.Key == key && uid != exceptUID {
+			gen.cancel()
+			delete(r.generations, uid)
+		}
+	}
+}
+
+// dhParamGeneration searches for safe prime in the background
+// once one of the workers becomes available.
+type dhParamGeneration struct {
+	Key    types.NamespacedName
+	Params dhParamParams
+
+	cancel           context.CancelFunc
+	candidatesTested int64
+
+	lock      sync.Mutex
+	startedAt time.Time
+	done      bool
+	prime     *big.Int
+	err       error
+}
+
+func newDHParamGeneration(key types.NamespacedName, params dhParamParams,
+	workers chan struct{}) *dhParamGeneration {
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	gen := &dhParamGeneration{
+		Key:    key,
+		Params: params,
+		cancel: cancel,
+	}
+
+	go func() {
+		select {
+		case workers <- struct{}{}:
+			defer func() { <-workers }()
+		case <-ctx.Done():
+			gen.finish(nil, ctx.Err())
+			return
+		}
+
+		gen.lock.Lock()
+		gen.startedAt = time.Now().UTC().Truncate(time.Second)
+		gen.lock.Unlock()
+
+		prime, err := generateSafePrime(ctx, params.Bits, &gen.candidatesTested)
+		gen.finish(prime, err)
+	}()
+
+	return gen
+}
+
+func (g *dhParamGeneration) finish(prime *big.Int, err error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.done = true
+	g.prime = prime
+	g.err = err
+}
+
+// StartedAt returns time when generation got a worker
+func (g *dhParamGeneration) StartedAt() (time.Time, bool) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	return g.startedAt, !g.startedAt.IsZero()
+}
+
+func (g *dhParamGeneration) CandidatesTested() int64 { return atomic.LoadInt64(&g.candidatesTested) }
+
+func (g *dhParamGeneration) Result() (*big.Int, bool, error) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	return g.prime, g.done, g.err
+}
+
+var (
+	// dhParamSmallPrimes are used to cheaply discard candidates
+	// before running expensive primality tests
+	dhParamSmallPrimes = func() []uint64 {
+		var primes []uint64
+		for i := int64(3); i < 10000; i += 2 {
+			if big.NewInt(i).ProbablyPrime(0) {
+				primes = append(primes, uint64(i))
+			}
+		}
+		return primes
+	}()
+)
+
+const (
+	// dhParamSieveRange is how far candidates are searched from random starting point
+	dhParamSieveRange = 1 << 20
+)
+
+// generateSafePrime finds prime p = 2q+1 (q also prime) suitable
+// for use with generator 2 (p = 23 mod 24, as checked by OpenSSL).
+// Candidates p, p+24, p+48, ... are sieved with small primes
+// before running primality tests.
+func generateSafePrime(ctx context.Context, bits int, candidatesTested *int64) (*big.Int, error) {
+	var (
+		one        = big.NewInt(1)
+		twentyFour = big.NewInt(24)
+		tmp        = new(big.Int)
+		residues   = make([]uint64, len(dhParamSmallPrimes))
+	)
+
+	for {
+		start, err := rand.Int(rand.Reader, new(big.Int).Lsh(one, uint(bits)))
+		if err != nil {
+			return nil, err
+		}
+
+		// Make sure candidates have exactly requested size and p = 23 mod 24
+		start.SetBit(start, bits-1, 1)
+		start.SetBit(start, bits-2, 1)
+		start.Sub(start, tmp.Mod(start, twentyFour))
+		start.Add(start, big.NewInt(23))
+
+		for i, sp := range dhParamSmallPrimes {
+			residues[i] = tmp.Mod(start, tmp.SetUint64(sp)).Uint64()
+		}
+
+	nextDelta:
+		for delta := uint64(0); delta < dhParamSieveRange; delta += 24 {
+			for i, sp := range dhParamSmallPrimes {
+				// p = 0 (mod sp) means p is divisible, p = 1 (mod sp) means q is divisible
+				pMod := (residues[i] + delta) % sp
+				if pMod == 0 || pMod == 1 {
+					continue nextDelta
+				}
+			}
+
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			atomic.AddInt64(candidatesTested, 1)
+
+			p := new(big.Int).Add(start, tmp.SetUint64(delta))
+			if p.BitLen() != bits {
+				break
+			}
+
+			q := new(big.Int).Rsh(p, 1)
+
+			if q.ProbablyPrime(0) && p.ProbablyPrime(0) && q.ProbablyPrime(20) && p.ProbablyPrime(20) {
+				return p, nil
+			}
+		}
+	}
+}
+
+// encodeDHParamPEM encodes parameters as PKCS#3 DHParameter
+// (same format as produced by `openssl dhparam`).
+func encodeDHParamPEM(prime *big.Int, generator int) (string, error) {
+	paramsBytes, err := asn1.Marshal(struct {
+		P *big.Int
+		G int
+	}{prime, generator})
+	if err != nil {
+		return "", fmt.Errorf("Marshaling parameters: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "DH PARAMETERS", Bytes: paramsBytes})), nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// DockerConfigReconciler produces registry credentials from Password Secrets.
+type DockerConfigReconciler struct {
+	sgClient      sgclient.Interface
+	coreClient    kubernetes.Interface
+	secretTracker Tracker
+	log           logr.Logger
+}
+
+var _ reconcile.Reconciler = &DockerConfigReconciler{}
+
+// NewDockerConfigReconciler constructs DockerConfigReconciler.
+func NewDockerConfigReconciler(sgClient sgclient.Interface, coreClient kubernetes.Interface,
+	secretTracker Tracker, log logr.Logger) *DockerConfigReconciler {
+	return &DockerConfigReconciler{sgClient, coreClient, secretTracker, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *DockerConfigReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch password Secrets so that credentials are updated when password rotates
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+		func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			secretKey := types.NamespacedName{Namespace: a.GetNamespace(), Name: a.GetName()}
+			for _, tracking := range r.secretTracker.GetTracking(secretKey) {
+				requests = append(requests, reconcile.Request{NamespacedName: tracking})
+			}
+			return requests
+		},
+	))
+	if err != nil {
+		return err
+	}
+
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.DockerConfig{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *DockerConfigReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	dockerConfig, err := r.sgClient.SecretgenV1alpha1().DockerConfigs(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			r.secretTracker.UntrackAll(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if dockerConfig.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          dockerConfig.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { dockerConfig.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(dockerConfig.ObjectMeta)
+	defer r.updateStatus(ctx, dockerConfig)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, dockerConfig))
+}
+
+func (r *DockerConfigReconciler) reconcile(ctx context.Context, dockerConfig *sgv1alpha1.DockerConfig) (reconcile.Result, error) {
+	err := dockerConfig.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	dockerConfigKey := types.NamespacedName{Namespace: dockerConfig.Namespace, Name: dockerConfig.Name}
+
+	r.secretTracker.UntrackAll(dockerConfigKey)
+	r.secretTracker.Track(dockerConfigKey, types.NamespacedName{
+		Namespace: dockerConfig.Namespace, Name: dockerConfig.Spec.PasswordRef.Name})
+
+	passwordSecret, err := r.coreClient.CoreV1().Secrets(dockerConfig.Namespace).Get(ctx, dockerConfig.Spec.PasswordRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Getting password secret: %s", err)
+	}
+
+	password, found := passwordSecret.Data[dockerConfig.Spec.PasswordRef.SecretKey()]
+	if !found || len(password) == 0 {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Expected password secret '%s' to have '%s' key",
+			passwordSecret.Name, dockerConfig.Spec.PasswordRef.SecretKey())
+	}
+
+	values, err := newDockerConfigValues(dockerConfig.Spec.Registry, dockerConfig.Spec.Username, string(password))
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.saveSecret(ctx, dockerConfig, values)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// newDockerConfigValues returns values available to Secret template
+// including .dockerconfigjson contents in the same form as produced
+// by `kubectl create secret docker-registry`.
+func newDockerConfigValues(registry, username, password string) (map[string][]byte, error) {
+	type authConf struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		Auth     string `json:"auth"`
+	}
+
+	type authsConf struct {
+		Auths map[string]authConf `json:"auths"`
+	}
+
+	auth := base64.StdEncoding.EncodeToString([]byte(username + ":" + password))
+
+	dockerConfigJSON, err := json.Marshal(authsConf{
+		Auths: map[string]authConf{
+			registry: {Username: username, Password: password, Auth: auth},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Marshaling docker config: %s", err)
+	}
+
+	return map[string][]byte{
+		sgv1alpha1.DockerConfigSecretDockerConfigJSONKey: dockerConfigJSON,
+		sgv1alpha1.DockerConfigSecretRegistryKey:         []byte(registry),
+		sgv1alpha1.DockerConfigSecretUsernameKey:         []byte(username),
+		sgv1alpha1.DockerConfigSecretPasswordKey:         []byte(password),
+		sgv1alpha1.DockerConfigSecretAuthKey:             []byte(auth),
+	}, nil
+}
+
+func (r *DockerConfigReconciler) saveSecret(ctx context.Context, dockerConfig *sgv1alpha1.DockerConfig, values map[string][]byte) error {
+	secret := reconciler.NewSecret(dockerConfig, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.DockerConfigSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.DockerConfigSecretDefaultKey: expansion.Variable(sgv1alpha1.DockerConfigSecretDockerConfigJSONKey),
+		},
+	}
+
+	err := secret.ApplyTemplates(defaultTemplate, dockerConfig.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(dockerConfig.Namespace).Get(ctx, dockerConfig.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting secret: %s", err)
+		}
+		_, err = applySecret(ctx, r.coreClient, nil, newSecret)
+		return err
+	}
+
+	if !metav1.IsControlledBy(existingSecret, dockerConfig) {
+		return fmt.Errorf("Expected secret '%s' to be controlled by DockerConfig '%s'", existingSecret.Name, dockerConfig.Name)
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *DockerConfigReconciler) updateStatus(ctx context.Context, dockerConfig *sgv1alpha1.DockerConfig) error {
+	existingConfig, err := r.sgClient.SecretgenV1alpha1().DockerConfigs(dockerConfig.Namespace).Get(ctx, dockerConfig.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching dockerconfig: %s", err)
+	}
+
+	existingConfig.Status = dockerConfig.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().DockerConfigs(existingConfig.Namespace).UpdateStatus(ctx, existingConfig, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating dockerconfig status: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxDurationDays is the largest number of days that fits into time.Duration
+const maxDurationDays = int64(math.MaxInt64 / (24 * time.Hour))
+
+// ParseDuration parses Go duration strings (e.g. "2160h", "1h30m")
+// additionally allowing leading number of days (e.g. "90d", "1d12h")
+// since lifetimes of secrets are commonly expressed in days.
+func ParseDuration(val string) (time.Duration, error) {
+	if len(val) == 0 {
+		return 0, fmt.Errorf("Parsing duration: expected to be non-empty")
+	}
+
+	var result time.Duration
+
+	rest := val
+
+	if idx := strings.Index(val, "d"); idx >= 0 {
+		days, err := strconv.ParseUint(val[:idx], 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("Parsing duration '%s': invalid number of days", val)
+		}
+		if days > uint64(maxDurationDays) {
+			return 0, fmt.Errorf("Parsing duration '%s': expected number of days to not exceed %d", val, maxDurationDays)
+		}
+		result = time.Duration(days) * 24 * time.Hour
+		rest = val[idx+1:]
+	}
+
+	if len(rest) > 0 {
+		dur, err := time.ParseDuration(rest)
+		if err != nil {
+			return 0, fmt.Errorf("Parsing duration '%s': %s", val, err)
+		}
+		if dur < 0 {
+			return 0, fmt.Errorf("Parsing duration '%s': expected to be non-negative", val)
+		}
+		if result > math.MaxInt64-dur {
+			return 0, fmt.Errorf("Parsing duration '%s': expected to not exceed %s", val, time.Duration(math.MaxInt64))
+		}
+		result += dur
+	}
+
+	return result, nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/generator"
+)
+
+func TestParseDuration(t *testing.T) {
+	validCases := map[string]time.Duration{
+		"2160h": 2160 * time.Hour,
+		"90d":   90 * 24 * time.Hour,
+		"1d12h": 36 * time.Hour,
+		"5m30s": 5*time.Minute + 30*time.Second,
+		"0d":    0,
+	}
+
+	for input, expected := range validCases {
+		t.Run(input, func(t *testing.T) {
+			dur, err := generator.ParseDuration(input)
+			require.NoError(t, err)
+			assert.Equal(t, expected, dur)
+		})
+	}
+
+	invalidCases := []string{"", "d", "-1d", "1.5d", "10", "1dd", "-5m", "1d-5m", "200000d", "106751d24h"}
+
+	for _, input := range invalidCases {
+		t.Run("invalid "+input, func(t *testing.T) {
+			_, err := generator.ParseDuration(input)
+			require.Error(t, err)
+		})
+	}
+}
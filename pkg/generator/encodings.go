@@ -0,0 +1,61 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+)
+
+// encodePrivateKeyPKCS8PEM encodes RSA, ECDSA or Ed25519 private key
+// in PKCS#8 form, which is commonly expected by Java and Go libraries.
+func encodePrivateKeyPKCS8PEM(key crypto.PrivateKey) (string, error) {
+	keyBytes, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling private key as PKCS#8: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyBytes})), nil
+}
+
+// encodePublicKeyPEM encodes public key as SubjectPublicKeyInfo (SPKI).
+func encodePublicKeyPEM(key crypto.PublicKey) (string, error) {
+	keyBytes, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling public key: %s", err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: keyBytes})), nil
+}
+
+// parsePrivateKeyPEM parses PEM encoded private key in PKCS#8,
+// PKCS#1 (RSA) or SEC 1 (ECDSA) form.
+func parsePrivateKeyPEM(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("Expected private key to be PEM formatted")
+	}
+
+	var key crypto.PrivateKey
+	var err error
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Parsing private key: %s", err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("Expected private key to be usable for signing")
+	}
+
+	return signer, nil
+}
@@ -0,0 +1,481 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	keyringKeysSecretKeysKey = "keys.json"
+	keyringKeyBytes          = 32
+)
+
+// EncryptionKeyringReconciler rotates symmetric encryption keys
+// keeping a limited number of previous keys for decryption.
+type EncryptionKeyringReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &EncryptionKeyringReconciler{}
+
+// NewEncryptionKeyringReconciler constructs EncryptionKeyringReconciler.
+func NewEncryptionKeyringReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *EncryptionKeyringReconciler {
+	return &EncryptionKeyringReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *EncryptionKeyringReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.EncryptionKeyring{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *EncryptionKeyringReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	keyring, err := r.sgClient.SecretgenV1alpha1().EncryptionKeyrings(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if keyring.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          keyring.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { keyring.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(keyring.ObjectMeta)
+	defer r.updateStatus(ctx, keyring)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, keyring))
+}
+
+// keyringKey is a single key tracked within keys Secret.
+type keyringKey struct {
+	Name      string    `json:"name"`
+	Algorithm string    `json:"algorithm"`
+	Provider  string    `json:"provider"`
+	Secret    string    `json:"secret"`
+	CreatedAt time.Time `json:"createdAt"`
+	// Staged key is the next primary key. It's only meant for decryption
+	// until it is promoted so that consumers pick it up in advance.
+	Staged bool `json:"staged,omitempty"`
+}
+
+// keyringJSON is the keyring representation exposed to applications.
+// Keys are ordered from newest to oldest; newest key could be staged.
+type keyringJSON struct {
+	Primary string       `json:"primary"`
+	Keys    []keyringKey `json:"keys"`
+}
+
+func (r *EncryptionKeyringReconciler) reconcile(ctx context.Context, keyring *sgv1alpha1.EncryptionKeyring) (reconcile.Result, error) {
+	err := keyring.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	rotationInterval := keyring.Spec.RotationInterval
+	if len(rotationInterval) == 0 {
+		rotationInterval = sgv1alpha1.EncryptionKeyringDefaultRotationInterval
+	}
+
+	interval, err := ParseDuration(rotationInterval)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: fmt.Errorf("Invalid rotationInterval: %s", err)}
+	}
+	if interval == 0 {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{
+			Err: fmt.Errorf("Invalid rotationInterval: expected to be greater than zero")}
+	}
+
+	keysSecret, keys, err := r.loadKeys(ctx, keyring)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	now := time.Now().UTC()
+	rotation := newRotation(keyring.ObjectMeta, keyring.Status.LastRotation)
+
+	keys, err = r.rotate(keyring, keys, interval, now, rotation.IsRequested())
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.saveKeys(ctx, keyring, keysSecret, keys)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.reconcileSecret(ctx, keyring, keys)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	// Staged key is always present after rotate
+	nextRotationTime := keys[0].CreatedAt.Add(interval)
+
+	keyring.Status.PrimaryKeyName = keyringPrimaryKey(keys).Name
+	keyring.Status.NextKeyName = keys[0].Name
+	keyring.Status.Keys = len(keys)
+	keyring.Status.NextRotationTime = &metav1.Time{Time: nextRotationTime}
+
+	if rotation.IsRequested() {
+		keyring.Status.LastRotation = rotation.Status()
+	}
+
+	requeueAfter := nextRotationTime.Sub(now) + time.Second
+	if requeueAfter < time.Second {
+		requeueAfter = time.Second
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// rotate keeps primary key and staged (next) key in keyring. Staged key is
+// listed for decryption only, and is promoted to primary once it has been
+// staged for rotation interval, so that consumers (e.g. API servers still
+// running with previous EncryptionConfiguration) are able to decrypt data
+// encrypted with it by the time it becomes primary. Rotation requested via
+// annotation promotes staged key right away. Staged key that does not match
+// settings is replaced as it has never been used for encryption.
+// Oldest keys beyond configured maximum (not counting staged key) are dropped.
+func (r *EncryptionKeyringReconciler) rotate(keyring *sgv1alpha1.EncryptionKeyring,
+	keys []*keyringKey, interval time.Duration, now time.Time, forced bool) ([]*keyringKey, error) {
+
+	// Name is picked before staged key is replaced so that names are never reused
+	nextName := r.nextKeyName(keys)
+
+	if len(keys) > 0 && keys[0].Staged && !r.matchesSettings(keyring, keys[0]) {
+		keys = keys[1:]
+	}
+
+	switch {
+	case len(keys) == 0:
+		// Nothing could have been encrypted yet hence first key is primary right away
+		key, err := r.newKey(keyring, nextName, now)
+		if err != nil {
+			return nil, err
+		}
+		keys = []*keyringKey{key}
+		nextName = r.nextKeyName(keys)
+
+	case keys[0].Staged && (forced || !now.Before(keys[0].CreatedAt.Add(interval))):
+		keys[0].Staged = false
+	}
+
+	if !keys[0].Staged {
+		key, err := r.newKey(keyring, nextName, now)
+		if err != nil {
+			return nil, err
+		}
+		key.Staged = true
+		keys = append([]*keyringKey{key}, keys...)
+	}
+
+	// Staged key is not counted towards maximum
+	if len(keys) > keyring.KeyMaxKeys()+1 {
+		keys = keys[:keyring.KeyMaxKeys()+1]
+	}
+
+	return keys, nil
+}
+
+func (r *EncryptionKeyringReconciler) matchesSettings(keyring *sgv1alpha1.EncryptionKeyring, key *keyringKey) bool {
+	return key.Algorithm == keyring.KeyAlgorithm() && key.Provider == keyring.KeyProvider()
+}
+
+func (r *EncryptionKeyringReconciler) newKey(keyring *sgv1alpha1.EncryptionKeyring,
+	name string, now time.Time) (*keyringKey, error) {
+
+	secret := make([]byte, keyringKeyBytes)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, fmt.Errorf("Generating key: %s", err)
+	}
+
+	return &keyringKey{
+		Name:      name,
+		Algorithm: keyring.KeyAlgorithm(),
+		Provider:  keyring.KeyProvider(),
+		Secret:    base64.StdEncoding.EncodeToString(secret),
+		CreatedAt: now,
+	}, nil
+}
+
+// keyringPrimaryKey returns newest key that is not staged
+func keyringPrimaryKey(keys []*keyringKey) *keyringKey {
+	for _, key := range keys {
+		if !key.Staged {
+			return key
+		}
+	}
+	panic("Internal inconsistency: expected keyring to have primary key")
+}
+
+// nextKeyName returns name that has not been used by any key
+// so that key names remain unique throughout keyring's lifetime.
+func (r *EncryptionKeyringReconciler) nextKeyName(keys []*keyringKey) string {
+	var last int
+
+	for _, key := range keys {
+		var num int
+		_, err := fmt.Sscanf(key.Name, "key%d", &num)
+		if err == nil && num > last {
+			last = num
+		}
+	}
+
+	return fmt.Sprintf("key%d", last+1)
+}
+
+func (r *EncryptionKeyringReconciler) loadKeys(ctx context.Context,
+	keyring *sgv1alpha1.EncryptionKeyring) (*corev1.Secret, []*keyringKey, error) {
+
+	secret, err := r.coreClient.CoreV1().Secrets(keyring.Namespace).Get(ctx, keyring.KeysSecretName(), metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("Getting keys secret: %s", err)
+	}
+
+	if !metav1.IsControlledBy(secret, keyring) {
+		return nil, nil, fmt.Errorf("Expected keys secret '%s' to be controlled by EncryptionKeyring '%s'", secret.Name, keyring.Name)
+	}
+
+	var keys []*keyringKey
+
+	err = json.Unmarshal(secret.Data[keyringKeysSecretKeysKey], &keys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unmarshaling keys: %s", err)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].CreatedAt.After(keys[j].CreatedAt) })
+
+	return secret, keys, nil
+}
+
+func (r *EncryptionKeyringReconciler) saveKeys(ctx context.Context, keyring *sgv1alpha1.EncryptionKeyring,
+	existingSecret *corev1.Secret, keys []*keyringKey) error {
+
+	keysBs, err := json.Marshal(keys)
+	if err != nil {
+		return fmt.Errorf("Marshaling keys: %s", err)
+	}
+
+	values := map[string][]byte{keyringKeysSecretKeysKey: keysBs}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      keyring.KeysSecretName(),
+			Namespace: keyring.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: values,
+	}
+
+	err = controllerutil.SetControllerReference(keyring, secret, scheme.Scheme)
+	if err != nil {
+		return fmt.Errorf("Setting owner of keys secret: %s", err)
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, secret)
+	if err != nil {
+		return fmt.Errorf("Saving keys secret: %s", err)
+	}
+
+	return nil
+}
+
+func (r *EncryptionKeyringReconciler) reconcileSecret(ctx context.Context,
+	keyring *sgv1alpha1.EncryptionKeyring, keys []*keyringKey) error {
+
+	values, err := r.keyringValues(keyring, keys)
+	if err != nil {
+		return err
+	}
+
+	secret := reconciler.NewSecret(keyring, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.EncryptionKeyringSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.EncryptionKeyringSecretDefaultKeyringKey:                 expansion.Variable(sgv1alpha1.EncryptionKeyringSecretKeyringKey),
+			sgv1alpha1.EncryptionKeyringSecretDefaultEncryptionConfigurationKey: expansion.Variable(sgv1alpha1.EncryptionKeyringSecretEncryptionConfigurationKey),
+		},
+	}
+
+	err = secret.ApplyTemplates(defaultTemplate, keyring.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(keyring.Namespace).Get(ctx, newSecret.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting secret: %s", err)
+		}
+		_, err = applySecret(ctx, r.coreClient, nil, newSecret)
+		return err
+	}
+
+	if !metav1.IsControlledBy(existingSecret, keyring) {
+		return fmt.Errorf("Expected secret '%s' to be controlled by EncryptionKeyring '%s'", existingSecret.Name, keyring.Name)
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *EncryptionKeyringReconciler) keyringValues(keyring *sgv1alpha1.EncryptionKeyring,
+	keys []*keyringKey) (map[string][]byte, error) {
+
+	primaryKey := keyringPrimaryKey(keys)
+	jsonKeyring := keyringJSON{Primary: primaryKey.Name}
+
+	for _, key := range keys {
+		jsonKeyring.Keys = append(jsonKeyring.Keys, *key)
+	}
+
+	keyringBs, err := json.Marshal(jsonKeyring)
+	if err != nil {
+		return nil, fmt.Errorf("Marshaling keyring: %s", err)
+	}
+
+	// Primary key goes first as it is used for encryption
+	configKeys := []*keyringKey{primaryKey}
+	for _, key := range keys {
+		if key != primaryKey {
+			configKeys = append(configKeys, key)
+		}
+	}
+
+	encConfigBs, err := newEncryptionConfiguration(keyring.KeyResources(), configKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		sgv1alpha1.EncryptionKeyringSecretKeyringKey:                 keyringBs,
+		sgv1alpha1.EncryptionKeyringSecretPrimaryKeyKey:              []byte(primaryKey.Secret),
+		sgv1alpha1.EncryptionKeyringSecretPrimaryKeyNameKey:          []byte(primaryKey.Name),
+		sgv1alpha1.EncryptionKeyringSecretAlgorithmKey:               []byte(primaryKey.Algorithm),
+		sgv1alpha1.EncryptionKeyringSecretEncryptionConfigurationKey: encConfigBs,
+	}, nil
+}
+
+type encryptionConfiguration struct {
+	APIVersion string                            `json:"apiVersion"`
+	Kind       string                            `json:"kind"`
+	Resources  []encryptionConfigurationResource `json:"resources"`
+}
+
+type encryptionConfigurationResource struct {
+	Resources []string                          `json:"resources"`
+	Providers []map[string]encryptionConfigKeys `json:"providers"`
+}
+
+type encryptionConfigKeys struct {
+	Keys []encryptionConfigKey `json:"keys,omitempty"`
+}
+
+type encryptionConfigKey struct {
+	Name   string `json:"name"`
+	Secret string `json:"secret"`
+}
+
+// newEncryptionConfiguration renders kube-apiserver EncryptionConfiguration.
+// Keys are grouped by provider keeping primary key's provider (and primary key,
+// expected to be the first given key) first as it is used for encryption. Identity provider comes last so that
+// data written before encryption was enabled remains readable.
+func newEncryptionConfiguration(resources []string, keys []*keyringKey) ([]byte, error) {
+	var providerNames []string
+	providerKeys := map[string][]encryptionConfigKey{}
+
+	for _, key := range keys {
+		if _, found := providerKeys[key.Provider]; !found {
+			providerNames = append(providerNames, key.Provider)
+		}
+		providerKeys[key.Provider] = append(providerKeys[key.Provider],
+			encryptionConfigKey{Name: key.Name, Secret: key.Secret})
+	}
+
+	var providers []map[string]encryptionConfigKeys
+
+	for _, name := range providerNames {
+		providers = append(providers, map[string]encryptionConfigKeys{name: {Keys: providerKeys[name]}})
+	}
+
+	providers = append(providers, map[string]encryptionConfigKeys{"identity": {}})
+
+	config := encryptionConfiguration{
+		APIVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "EncryptionConfiguration",
+		Resources: []encryptionConfigurationResource{{
+			Resources: resources,
+			Providers: providers,
+		}},
+	}
+
+	configBs, err := yaml.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("Marshaling encryption configuration: %s", err)
+	}
+
+	return configBs, nil
+}
+
+func (r *EncryptionKeyringReconciler) updateStatus(ctx context.Context, keyring *sgv1alpha1.EncryptionKeyring) error {
+	existingKeyring, err := r.sgClient.SecretgenV1alpha1().EncryptionKeyrings(keyring.Namespace).Get(ctx, keyring.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching encryptionkeyring: %s", err)
+	}
+
+	existingKeyring.Status = keyring.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().EncryptionKeyrings(existingKeyring.Namespace).UpdateStatus(ctx, existingKeyring, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating encryptionkeyring status: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+)
+
+func TestEncryptionKeyringRotate(t *testing.T) {
+	r := &EncryptionKeyringReconciler{}
+	keyring := &sgv1alpha1.EncryptionKeyring{Spec: sgv1alpha1.EncryptionKeyringSpec{MaxKeys: 2}}
+	interval := time.Hour
+	start := time.Now().UTC()
+
+	keyNames := func(keys []*keyringKey) []string {
+		var names []string
+		for _, key := range keys {
+			names = append(names, key.Name)
+		}
+		return names
+	}
+
+	t.Run("stages next key before promoting it", func(t *testing.T) {
+		keys, err := r.rotate(keyring, nil, interval, start, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"key2", "key1"}, keyNames(keys))
+		assert.True(t, keys[0].Staged)
+		assert.Equal(t, "key1", keyringPrimaryKey(keys).Name)
+
+		keys, err = r.rotate(keyring, keys, interval, start.Add(interval/2), false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"key2", "key1"}, keyNames(keys))
+
+		stagedSecret := keys[0].Secret
+
+		keys, err = r.rotate(keyring, keys, interval, start.Add(interval), false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"key3", "key2", "key1"}, keyNames(keys))
+		assert.True(t, keys[0].Staged)
+		assert.Equal(t, "key2", keyringPrimaryKey(keys).Name)
+		assert.Equal(t, stagedSecret, keys[1].Secret)
+
+		keys, err = r.rotate(keyring, keys, interval, start.Add(2*interval), false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"key4", "key3", "key2"}, keyNames(keys), "Expected staged key not to be counted")
+	})
+
+	t.Run("promotes staged key when rotation is forced", func(t *testing.T) {
+		keys, err := r.rotate(keyring, nil, interval, start, false)
+		require.NoError(t, err)
+
+		keys, err = r.rotate(keyring, keys, interval, start, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"key3", "key2", "key1"}, keyNames(keys))
+		assert.Equal(t, "key2", keyringPrimaryKey(keys).Name)
+	})
+
+	t.Run("replaces staged key that does not match settings without promoting it", func(t *testing.T) {
+		keys, err := r.rotate(keyring, nil, interval, start, false)
+		require.NoError(t, err)
+
+		changedKeyring := keyring.DeepCopy()
+		changedKeyring.Spec.Algorithm = sgv1alpha1.EncryptionKeyringAlgorithmXSalsa20Poly1305
+
+		keys, err = r.rotate(changedKeyring, keys, interval, start.Add(interval/2), true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"key3", "key1"}, keyNames(keys))
+		assert.True(t, keys[0].Staged)
+		assert.Equal(t, sgv1alpha1.EncryptionKeyringProviderSecretbox, keys[0].Provider)
+		assert.Equal(t, "key1", keyringPrimaryKey(keys).Name)
+	})
+}
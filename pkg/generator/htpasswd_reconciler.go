@@ -0,0 +1,385 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// HtpasswdReconciler produces htpasswd files from Password Secrets.
+type HtpasswdReconciler struct {
+	sgClient      sgclient.Interface
+	coreClient    kubernetes.Interface
+	secretTracker Tracker
+	log           logr.Logger
+}
+
+var _ reconcile.Reconciler = &HtpasswdReconciler{}
+
+// NewHtpasswdReconciler constructs HtpasswdReconciler.
+func NewHtpasswdReconciler(sgClient sgclient.Interface, coreClient kubernetes.Interface,
+	secretTracker Tracker, log logr.Logger) *HtpasswdReconciler {
+	return &HtpasswdReconciler{sgClient, coreClient, secretTracker, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *HtpasswdReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch Password Secrets so that htpasswd is re-rendered when passwords rotate
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+		func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			secretKey := types.NamespacedName{Namespace: a.GetNamespace(), Name: a.GetName()}
+			for _, tracking := range r.secretTracker.GetTracking(secretKey) {
+				requests = append(requests, reconcile.Request{NamespacedName: tracking})
+			}
+			return requests
+		},
+	))
+	if err != nil {
+		return err
+	}
+
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.Htpasswd{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *HtpasswdReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	htpasswd, err := r.sgClient.SecretgenV1alpha1().Htpasswds(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			r.secretTracker.UntrackAll(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if htpasswd.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          htpasswd.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { htpasswd.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(htpasswd.ObjectMeta)
+	defer r.updateStatus(ctx, htpasswd)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, htpasswd))
+}
+
+// htpasswdParams are recorded in generate inputs annotation
+// hence changing them (e.g. rotating password) results in file being
+// re-rendered. Passwords are salted, so unchanged inputs keep existing hashes.
+// Passwords themselves (or their digests) are never recorded; password Secret
+// identity and version are recorded instead.
+type htpasswdParams struct {
+	Algorithm  string
+	BcryptCost int
+	Users      []htpasswdUserParams
+}
+
+type htpasswdUserParams struct {
+	Username                      string
+	PasswordSecretUID             types.UID
+	PasswordSecretResourceVersion string
+}
+
+// htpasswdPassword is password read from referenced Secret
+type htpasswdPassword struct {
+	Value  []byte
+	Secret *corev1.Secret
+}
+
+func (r *HtpasswdReconciler) reconcile(ctx context.Context, htpasswd *sgv1alpha1.Htpasswd) (reconcile.Result, error) {
+	err := htpasswd.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	htpasswdKey := types.NamespacedName{Namespace: htpasswd.Namespace, Name: htpasswd.Name}
+
+	r.secretTracker.UntrackAll(htpasswdKey)
+
+	for _, user := range htpasswd.Spec.Users {
+		r.secretTracker.Track(htpasswdKey, types.NamespacedName{Namespace: htpasswd.Namespace, Name: user.PasswordRef.Name})
+	}
+
+	passwords, err := r.getPasswords(ctx, htpasswd)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if htpasswd.HashAlgorithm() == sgv1alpha1.HtpasswdAlgorithmBcrypt {
+		for i, user := range htpasswd.Spec.Users {
+			if len(passwords[i].Value) > htpasswdMaxBcryptPasswordLen {
+				// Password Secret is watched hence reconcile happens once password changes
+				return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: fmt.Errorf(
+					"Expected password for user '%s' to be at most %d bytes long for bcrypt algorithm, but was %d bytes",
+					user.Username, htpasswdMaxBcryptPasswordLen, len(passwords[i].Value))}
+			}
+		}
+	}
+
+	params := htpasswdParams{
+		Algorithm:  htpasswd.HashAlgorithm(),
+		BcryptCost: htpasswd.HashBcryptCost(),
+	}
+
+	for i, user := range htpasswd.Spec.Users {
+		params.Users = append(params.Users, htpasswdUserParams{
+			Username:                      user.Username,
+			PasswordSecretUID:             passwords[i].Secret.UID,
+			PasswordSecretResourceVersion: passwords[i].Secret.ResourceVersion,
+		})
+	}
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(htpasswd.Namespace).Get(ctx, htpasswd.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, err
+		}
+		existingSecret = nil
+	}
+
+	if existingSecret != nil {
+		if !metav1.IsControlledBy(existingSecret, htpasswd) {
+			return reconcile.Result{}, fmt.Errorf("Expected secret '%s' to be controlled by Htpasswd '%s'", existingSecret.Name, htpasswd.Name)
+		}
+		if !(GenerateInputs{params}).IsChanged(existingSecret.Annotations) {
+			htpasswd.Status.Users = len(htpasswd.Spec.Users)
+			return reconcile.Result{}, nil
+		}
+	}
+
+	var auth bytes.Buffer
+
+	for i, user := range htpasswd.Spec.Users {
+		hash, err := hashHtpasswdPassword(htpasswd.HashAlgorithm(), htpasswd.HashBcryptCost(), passwords[i].Value)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, fmt.Errorf("Hashing password for user '%s': %s", user.Username, err)
+		}
+		fmt.Fprintf(&auth, "%s:%s\n", user.Username, hash)
+	}
+
+	err = r.saveSecret(ctx, htpasswd, existingSecret, params, auth.String())
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	htpasswd.Status.Users = len(htpasswd.Spec.Users)
+
+	return reconcile.Result{}, nil
+}
+
+func (r *HtpasswdReconciler) getPasswords(ctx context.Context, htpasswd *sgv1alpha1.Htpasswd) ([]htpasswdPassword, error) {
+	var passwords []htpasswdPassword
+
+	for _, user := range htpasswd.Spec.Users {
+		secret, err := r.coreClient.CoreV1().Secrets(htpasswd.Namespace).Get(ctx, user.PasswordRef.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("Getting password secret for user '%s': %s", user.Username, err)
+		}
+
+		password, found := secret.Data[user.PasswordRef.SecretKey()]
+		if !found || len(password) == 0 {
+			return nil, fmt.Errorf("Expected password secret '%s' to have '%s' key", secret.Name, user.PasswordRef.SecretKey())
+		}
+
+		passwords = append(passwords, htpasswdPassword{Value: password, Secret: secret})
+	}
+
+	return passwords, nil
+}
+
+func (r *HtpasswdReconciler) saveSecret(ctx context.Context, htpasswd *sgv1alpha1.Htpasswd,
+	existingSecret *corev1.Secret, params htpasswdParams, auth string) error {
+
+	values := map[string][]byte{
+		sgv1alpha1.HtpasswdSecretAuthKey: []byte(auth),
+	}
+
+	secret := reconciler.NewSecret(htpasswd, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.HtpasswdSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.HtpasswdSecretDefaultAuthKey: expansion.Variable(sgv1alpha1.HtpasswdSecretAuthKey),
+		},
+	}
+
+	err := secret.ApplyTemplates(defaultTemplate, htpasswd.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = GenerateInputs{params}.Add(newSecret.Annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *HtpasswdReconciler) updateStatus(ctx context.Context, htpasswd *sgv1alpha1.Htpasswd) error {
+	existingHtpasswd, err := r.sgClient.SecretgenV1alpha1().Htpasswds(htpasswd.Namespace).Get(ctx, htpasswd.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching htpasswd: %s", err)
+	}
+
+	existingHtpasswd.Status = htpasswd.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().Htpasswds(existingHtpasswd.Namespace).UpdateStatus(ctx, existingHtpasswd, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating htpasswd status: %s", err)
+	}
+
+	return nil
+}
+
+// hashHtpasswdPassword hashes password in one of the formats understood by
+// Apache's htpasswd (and hence by nginx, Traefik and Docker registry).
+func hashHtpasswdPassword(algorithm string, bcryptCost int, password []byte) (string, error) {
+	switch algorithm {
+	case sgv1alpha1.HtpasswdAlgorithmBcrypt:
+		hash, err := bcrypt.GenerateFromPassword(password, bcryptCost)
+		if err != nil {
+			return "", err
+		}
+		return string(hash), nil
+
+	case sgv1alpha1.HtpasswdAlgorithmApr1:
+		salt, err := newApr1Salt()
+		if err != nil {
+			return "", err
+		}
+		return apr1Hash(password, salt), nil
+
+	case sgv1alpha1.HtpasswdAlgorithmSHA:
+		sum := sha1.Sum(password)
+		return "{SHA}" + base64.StdEncoding.EncodeToString(sum[:]), nil
+
+	default:
+		return "", fmt.Errorf("Unknown algorithm '%s'", algorithm)
+	}
+}
+
+// htpasswdMaxBcryptPasswordLen is the longest password bcrypt accepts
+const htpasswdMaxBcryptPasswordLen = 72
+
+const apr1Alphabet = "./0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+func newApr1Salt() (string, error) {
+	salt := make([]byte, 8)
+	for i := range salt {
+		idx, err := rand.Int(rand.Reader, big.NewInt(int64(len(apr1Alphabet))))
+		if err != nil {
+			return "", err
+		}
+		salt[i] = apr1Alphabet[idx.Int64()]
+	}
+	return string(salt), nil
+}
+
+// apr1Hash implements Apache's variant of MD5-based crypt.
+func apr1Hash(password []byte, salt string) string {
+	const magic = "$apr1$"
+
+	alt := md5.New()
+	alt.Write(password)
+	alt.Write([]byte(salt))
+	alt.Write(password)
+	altSum := alt.Sum(nil)
+
+	ctx := md5.New()
+	ctx.Write(password)
+	ctx.Write([]byte(magic))
+	ctx.Write([]byte(salt))
+	for i := len(password); i > 0; i -= 16 {
+		if i > 16 {
+			ctx.Write(altSum)
+		} else {
+			ctx.Write(altSum[:i])
+		}
+	}
+	for i := len(password); i > 0; i >>= 1 {
+		if i&1 != 0 {
+			ctx.Write([]byte{0})
+		} else {
+			ctx.Write(password[:1])
+		}
+	}
+	final := ctx.Sum(nil)
+
+	for i := 0; i < 1000; i++ {
+		round := md5.New()
+		if i&1 != 0 {
+			round.Write(password)
+		} else {
+			round.Write(final)
+		}
+		if i%3 != 0 {
+			round.Write([]byte(salt))
+		}
+		if i%7 != 0 {
+			round.Write(password)
+		}
+		if i&1 != 0 {
+			round.Write(final)
+		} else {
+			round.Write(password)
+		}
+		final = round.Sum(nil)
+	}
+
+	var out bytes.Buffer
+
+	encode := func(v uint, n int) {
+		for ; n > 0; n-- {
+			out.WriteByte(apr1Alphabet[v&0x3f])
+			v >>= 6
+		}
+	}
+
+	for _, idx := range [][3]int{{0, 6, 12}, {1, 7, 13}, {2, 8, 14}, {3, 9, 15}, {4, 10, 5}} {
+		encode(uint(final[idx[0]])<<16|uint(final[idx[1]])<<8|uint(final[idx[2]]), 4)
+	}
+	encode(uint(final[11]), 2)
+
+	return magic + salt + "$" + out.String()
+}
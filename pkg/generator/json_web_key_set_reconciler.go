@@ -0,0 +1,587 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	jwksKeysSecretKeysKey = "keys.json"
+)
+
+// JSONWebKeySetReconciler rotates signing keys and publishes
+// their public parts as a JSON Web Key Set.
+type JSONWebKeySetReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &JSONWebKeySetReconciler{}
+
+// NewJSONWebKeySetReconciler constructs JSONWebKeySetReconciler.
+func NewJSONWebKeySetReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *JSONWebKeySetReconciler {
+	return &JSONWebKeySetReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *JSONWebKeySetReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.JSONWebKeySet{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *JSONWebKeySetReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	keySet, err := r.sgClient.SecretgenV1alpha1().JSONWebKeySets(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if keySet.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          keySet.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { keySet.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(keySet.ObjectMeta)
+	defer r.updateStatus(ctx, keySet)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, keySet))
+}
+
+// jwksKey is a single key tracked within keys Secret.
+type jwksKey struct {
+	KeyID       string    `json:"kid"`
+	Algorithm   string    `json:"algorithm"`
+	Bits        int       `json:"bits,omitempty"`
+	PrivateKey  string    `json:"privateKey"`
+	PublishedAt time.Time `json:"publishedAt"`
+	ActivatesAt time.Time `json:"activatesAt"`
+}
+
+// jwksSchedule describes rotation settings of JSONWebKeySet.
+type jwksSchedule struct {
+	Algorithm        string
+	Bits             int
+	RotationInterval time.Duration
+	Overlap          time.Duration
+}
+
+func newJWKSSchedule(keySet *sgv1alpha1.JSONWebKeySet) (jwksSchedule, error) {
+	sched := jwksSchedule{Algorithm: keySet.KeyAlgorithm(), Bits: keySet.Spec.Bits}
+
+	if strings.HasPrefix(sched.Algorithm, "RS") && sched.Bits == 0 {
+		sched.Bits = sgv1alpha1.RSAKeyDefaultBits
+	}
+
+	rotationInterval := keySet.Spec.RotationInterval
+	if len(rotationInterval) == 0 {
+		rotationInterval = sgv1alpha1.JSONWebKeySetDefaultRotationInterval
+	}
+
+	var err error
+
+	sched.RotationInterval, err = ParseDuration(rotationInterval)
+	if err != nil {
+		return jwksSchedule{}, fmt.Errorf("Invalid rotationInterval: %s", err)
+	}
+	if sched.RotationInterval == 0 {
+		return jwksSchedule{}, fmt.Errorf("Invalid rotationInterval: expected to be greater than zero")
+	}
+
+	overlap := keySet.Spec.Overlap
+	if len(overlap) == 0 {
+		overlap = sgv1alpha1.JSONWebKeySetDefaultOverlap
+	}
+
+	sched.Overlap, err = ParseDuration(overlap)
+	if err != nil {
+		return jwksSchedule{}, fmt.Errorf("Invalid overlap: %s", err)
+	}
+	if sched.Overlap > sched.RotationInterval {
+		return jwksSchedule{}, fmt.Errorf("Invalid overlap: expected to not exceed rotationInterval")
+	}
+
+	return sched, nil
+}
+
+func (r *JSONWebKeySetReconciler) reconcile(ctx context.Context, keySet *sgv1alpha1.JSONWebKeySet) (reconcile.Result, error) {
+	err := keySet.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	sched, err := newJWKSSchedule(keySet)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	keysSecret, keys, err := r.loadKeys(ctx, keySet)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	now := time.Now().UTC()
+
+	previous, current, next, err := r.rotate(keys, sched, now)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.saveKeys(ctx, keySet, keysSecret, []*jwksKey{previous, current, next})
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.reconcileSecret(ctx, keySet, current)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.reconcileConfigMap(ctx, keySet, []*jwksKey{current, next, previous})
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	keySet.Status.CurrentKeyID = current.KeyID
+	keySet.Status.NextKeyID = next.KeyID
+	keySet.Status.PreviousKeyID = ""
+	if previous != nil {
+		keySet.Status.PreviousKeyID = previous.KeyID
+	}
+	keySet.Status.NextRotationTime = &metav1.Time{Time: next.ActivatesAt}
+
+	// Come back either when next key becomes current
+	// or when previous key should be unpublished
+	requeueAt := next.ActivatesAt
+	if previous != nil && current.ActivatesAt.Add(sched.Overlap).Before(requeueAt) {
+		requeueAt = current.ActivatesAt.Add(sched.Overlap)
+	}
+
+	// Scheduled time may have already passed (e.g. overlap of zero or
+	// controller downtime) and non-positive RequeueAfter is not scheduled at all
+	requeueAfter := requeueAt.Sub(now) + time.Second
+	if requeueAfter < time.Second {
+		requeueAfter = time.Second
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// rotate determines previous (optional), current and next keys
+// generating new keys when necessary. Next key is always published
+// for at least overlap duration before it is used for signing so that
+// verifiers that cache key set would know about it in time.
+func (r *JSONWebKeySetReconciler) rotate(keys []*jwksKey, sched jwksSchedule,
+	now time.Time) (*jwksKey, *jwksKey, *jwksKey, error) {
+
+	var previous, current, next *jwksKey
+
+	for _, key := range keys {
+		if key.ActivatesAt.After(now) {
+			// Only the earliest upcoming key matching settings is kept
+			if next == nil && key.Algorithm == sched.Algorithm && key.Bits == sched.Bits {
+				next = key
+			}
+			continue
+		}
+		previous = current
+		current = key
+	}
+
+	if current == nil {
+		var err error
+
+		current, err = r.generateKey(sched, now, now)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if previous != nil && !now.Before(current.ActivatesAt.Add(sched.Overlap)) {
+		previous = nil
+	}
+
+	if next == nil {
+		var err error
+
+		next, err = r.generateKey(sched, now, time.Time{})
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	// Activation time follows settings since next key was not used yet
+	next.ActivatesAt = current.ActivatesAt.Add(sched.RotationInterval)
+	if minActivatesAt := next.PublishedAt.Add(sched.Overlap); next.ActivatesAt.Before(minActivatesAt) {
+		next.ActivatesAt = minActivatesAt
+	}
+
+	return previous, current, next, nil
+}
+
+func (r *JSONWebKeySetReconciler) generateKey(sched jwksSchedule,
+	now time.Time, activatesAt time.Time) (*jwksKey, error) {
+
+	privateKey, err := generateSigningKey(sched.Algorithm, sched.Bits)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, err := encodePrivateKeyPKCS8PEM(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	_, publicJWK, err := newJWKs(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &jwksKey{
+		KeyID:       publicJWK.Kid,
+		Algorithm:   sched.Algorithm,
+		Bits:        sched.Bits,
+		PrivateKey:  privateKeyPEM,
+		PublishedAt: now,
+		ActivatesAt: activatesAt,
+	}, nil
+}
+
+// generateSigningKey generates key suitable for given JWS algorithm.
+func generateSigningKey(alg string, bits int) (crypto.Signer, error) {
+	switch alg {
+	case "RS256", "RS384", "RS512":
+		key, err := rsa.GenerateKey(rand.Reader, bits)
+		if err != nil {
+			return nil, fmt.Errorf("Generating RSA key: %s", err)
+		}
+		return key, nil
+
+	case "ES256", "ES384":
+		curve := elliptic.P256()
+		if alg == "ES384" {
+			curve = elliptic.P384()
+		}
+		key, err := ecdsa.GenerateKey(curve, rand.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("Generating ECDSA key: %s", err)
+		}
+		return key, nil
+
+	default:
+		return nil, fmt.Errorf("Unsupported algorithm '%s'", alg)
+	}
+}
+
+func (k jwksKey) parse() (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(k.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("Private key '%s' did not contain PEM formatted block", k.KeyID)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Parsing private key '%s': %s", k.KeyID, err)
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("Expected private key '%s' to be a signer", k.KeyID)
+	}
+
+	return signer, nil
+}
+
+func (r *JSONWebKeySetReconciler) loadKeys(ctx context.Context,
+	keySet *sgv1alpha1.JSONWebKeySet) (*corev1.Secret, []*jwksKey, error) {
+
+	secret, err := r.coreClient.CoreV1().Secrets(keySet.Namespace).Get(ctx, keySet.KeysSecretName(), metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("Getting keys secret: %s", err)
+	}
+
+	if !metav1.IsControlledBy(secret, keySet) {
+		return nil, nil, fmt.Errorf("Expected keys secret '%s' to be controlled by JSONWebKeySet '%s'", secret.Name, keySet.Name)
+	}
+
+	var keys []*jwksKey
+
+	err = json.Unmarshal(secret.Data[jwksKeysSecretKeysKey], &keys)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unmarshaling keys: %s", err)
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].ActivatesAt.Before(keys[j].ActivatesAt) })
+
+	return secret, keys, nil
+}
+
+func (r *JSONWebKeySetReconciler) saveKeys(ctx context.Context, keySet *sgv1alpha1.JSONWebKeySet,
+	existingSecret *corev1.Secret, keys []*jwksKey) error {
+
+	var keptKeys []*jwksKey
+
+	for _, key := range keys {
+		if key != nil {
+			keptKeys = append(keptKeys, key)
+		}
+	}
+
+	keysBs, err := json.Marshal(keptKeys)
+	if err != nil {
+		return fmt.Errorf("Marshaling keys: %s", err)
+	}
+
+	values := map[string][]byte{jwksKeysSecretKeysKey: keysBs}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      keySet.KeysSecretName(),
+			Namespace: keySet.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: values,
+	}
+
+	err = controllerutil.SetControllerReference(keySet, secret, scheme.Scheme)
+	if err != nil {
+		return fmt.Errorf("Setting owner of keys secret: %s", err)
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, secret)
+	if err != nil {
+		return fmt.Errorf("Saving keys secret: %s", err)
+	}
+
+	return nil
+}
+
+func (r *JSONWebKeySetReconciler) reconcileSecret(ctx context.Context,
+	keySet *sgv1alpha1.JSONWebKeySet, current *jwksKey) error {
+
+	privateKey, err := current.parse()
+	if err != nil {
+		return err
+	}
+
+	values, err := r.signingKeyValues(privateKey, current)
+	if err != nil {
+		return err
+	}
+
+	secret := reconciler.NewSecret(keySet, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.JSONWebKeySetSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.JSONWebKeySetSecretDefaultPrivateKeyKey: expansion.Variable(sgv1alpha1.JSONWebKeySetSecretPrivateKeyKey),
+			sgv1alpha1.JSONWebKeySetSecretDefaultJWKKey:        expansion.Variable(sgv1alpha1.JSONWebKeySetSecretJWKKey),
+			sgv1alpha1.JSONWebKeySetSecretDefaultKeyIDKey:      expansion.Variable(sgv1alpha1.JSONWebKeySetSecretKeyIDKey),
+		},
+	}
+
+	err = secret.ApplyTemplates(defaultTemplate, keySet.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(keySet.Namespace).Get(ctx, newSecret.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting secret: %s", err)
+		}
+		_, err = applySecret(ctx, r.coreClient, nil, newSecret)
+		return err
+	}
+
+	if !metav1.IsControlledBy(existingSecret, keySet) {
+		return fmt.Errorf("Expected secret '%s' to be controlled by JSONWebKeySet '%s'", existingSecret.Name, keySet.Name)
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *JSONWebKeySetReconciler) signingKeyValues(privateKey crypto.Signer, key *jwksKey) (map[string][]byte, error) {
+	privateKeyPKCS8, err := encodePrivateKeyPKCS8PEM(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := encodePublicKeyPEM(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	privateJWK, publicJWK, err := newJWKs(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateJWK.Use, privateJWK.Alg = "sig", key.Algorithm
+	publicJWK.Use, publicJWK.Alg = "sig", key.Algorithm
+
+	privateJWKStr, err := privateJWK.AsString()
+	if err != nil {
+		return nil, err
+	}
+
+	publicJWKStr, err := publicJWK.AsString()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		sgv1alpha1.JSONWebKeySetSecretPrivateKeyKey:      []byte(privateKeyPKCS8),
+		sgv1alpha1.JSONWebKeySetSecretPrivateKeyPKCS8Key: []byte(privateKeyPKCS8),
+		sgv1alpha1.JSONWebKeySetSecretPublicKeyKey:       []byte(publicKey),
+		sgv1alpha1.JSONWebKeySetSecretJWKKey:             []byte(privateJWKStr),
+		sgv1alpha1.JSONWebKeySetSecretPublicJWKKey:       []byte(publicJWKStr),
+		sgv1alpha1.JSONWebKeySetSecretKeyIDKey:           []byte(key.KeyID),
+		sgv1alpha1.JSONWebKeySetSecretAlgorithmKey:       []byte(key.Algorithm),
+	}, nil
+}
+
+func (r *JSONWebKeySetReconciler) reconcileConfigMap(ctx context.Context,
+	keySet *sgv1alpha1.JSONWebKeySet, keys []*jwksKey) error {
+
+	jwks := struct {
+		Keys []jsonWebKey `json:"keys"`
+	}{}
+
+	for _, key := range keys {
+		if key == nil {
+			continue
+		}
+
+		privateKey, err := key.parse()
+		if err != nil {
+			return err
+		}
+
+		_, publicJWK, err := newJWKs(privateKey)
+		if err != nil {
+			return err
+		}
+
+		publicJWK.Use, publicJWK.Alg = "sig", key.Algorithm
+
+		jwks.Keys = append(jwks.Keys, publicJWK)
+	}
+
+	jwksBs, err := json.Marshal(jwks)
+	if err != nil {
+		return fmt.Errorf("Marshaling key set: %s", err)
+	}
+
+	data := map[string]string{sgv1alpha1.JSONWebKeySetConfigMapJWKSKey: string(jwksBs)}
+
+	configMaps := r.coreClient.CoreV1().ConfigMaps(keySet.Namespace)
+
+	existingConfigMap, err := configMaps.Get(ctx, keySet.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting config map: %s", err)
+		}
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      keySet.Name,
+				Namespace: keySet.Namespace,
+				Labels:    keySet.Labels,
+			},
+			Data: data,
+		}
+
+		err = controllerutil.SetControllerReference(keySet, configMap, scheme.Scheme)
+		if err != nil {
+			return fmt.Errorf("Setting owner of config map: %s", err)
+		}
+
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("Creating config map: %s", err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(existingConfigMap, keySet) {
+		return fmt.Errorf("Expected config map '%s' to be controlled by JSONWebKeySet '%s'", existingConfigMap.Name, keySet.Name)
+	}
+
+	if reflect.DeepEqual(existingConfigMap.Data, data) {
+		return nil
+	}
+
+	existingConfigMap.Data = data
+
+	_, err = configMaps.Update(ctx, existingConfigMap, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating config map: %s", err)
+	}
+
+	return nil
+}
+
+func (r *JSONWebKeySetReconciler) updateStatus(ctx context.Context, keySet *sgv1alpha1.JSONWebKeySet) error {
+	existingKeySet, err := r.sgClient.SecretgenV1alpha1().JSONWebKeySets(keySet.Namespace).Get(ctx, keySet.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching jsonwebkeyset: %s", err)
+	}
+
+	existingKeySet.Status = keySet.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().JSONWebKeySets(existingKeySet.Namespace).UpdateStatus(ctx, existingKeySet, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating jsonwebkeyset status: %s", err)
+	}
+
+	return nil
+}
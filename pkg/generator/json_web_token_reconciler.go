@@ -0,0 +1,456 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// jwtMinExpiresIn prevents tokens from being re-minted in a tight loop
+	jwtMinExpiresIn = time.Minute
+)
+
+// JSONWebTokenReconciler mints JWTs signed by keys of other generators.
+type JSONWebTokenReconciler struct {
+	sgClient      sgclient.Interface
+	coreClient    kubernetes.Interface
+	secretTracker Tracker
+	log           logr.Logger
+}
+
+var _ reconcile.Reconciler = &JSONWebTokenReconciler{}
+
+// NewJSONWebTokenReconciler constructs JSONWebTokenReconciler.
+func NewJSONWebTokenReconciler(sgClient sgclient.Interface, coreClient kubernetes.Interface,
+	secretTracker Tracker, log logr.Logger) *JSONWebTokenReconciler {
+	return &JSONWebTokenReconciler{sgClient, coreClient, secretTracker, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *JSONWebTokenReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch signing key Secrets so that tokens are re-minted when key changes
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+		func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			secretKey := types.NamespacedName{Namespace: a.GetNamespace(), Name: a.GetName()}
+			for _, tracking := range r.secretTracker.GetTracking(secretKey) {
+				requests = append(requests, reconcile.Request{NamespacedName: tracking})
+			}
+			return requests
+		},
+	))
+	if err != nil {
+		return err
+	}
+
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.JSONWebToken{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *JSONWebTokenReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	token, err := r.sgClient.SecretgenV1alpha1().JSONWebTokens(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			r.secretTracker.UntrackAll(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if token.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          token.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { token.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(token.ObjectMeta)
+	defer r.updateStatus(ctx, token)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, token))
+}
+
+// jwtParams are recorded in generate inputs annotation
+// hence changing them results in token being re-minted.
+type jwtParams struct {
+	Algorithm        string
+	KeyID            string
+	KeySecretVersion string
+	Claims           map[string]interface{}
+	ExpiresIn        time.Duration
+}
+
+func (r *JSONWebTokenReconciler) reconcile(ctx context.Context, token *sgv1alpha1.JSONWebToken) (reconcile.Result, error) {
+	err := token.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	var expiresIn time.Duration
+
+	if len(token.Spec.ExpiresIn) > 0 {
+		expiresIn, err = ParseDuration(token.Spec.ExpiresIn)
+		if err != nil {
+			return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: fmt.Errorf("Invalid expiresIn: %s", err)}
+		}
+		if expiresIn < jwtMinExpiresIn {
+			return reconcile.Result{}, reconciler.TerminalReconcileErr{
+				Err: fmt.Errorf("Invalid expiresIn: expected to be at least %s", jwtMinExpiresIn)}
+		}
+	}
+
+	specClaims, err := token.ClaimValues()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	tokenKey := types.NamespacedName{Namespace: token.Namespace, Name: token.Name}
+	keySecretKey := types.NamespacedName{Namespace: token.Namespace, Name: token.Spec.SigningKeyRef.Name}
+
+	r.secretTracker.UntrackAll(tokenKey)
+	r.secretTracker.Track(tokenKey, keySecretKey)
+
+	signer, err := r.getSigner(ctx, token)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	params := jwtParams{
+		Algorithm:        signer.Algorithm,
+		KeyID:            signer.KeyID,
+		KeySecretVersion: signer.KeySecretVersion,
+		Claims:           specClaims,
+		ExpiresIn:        expiresIn,
+	}
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(token.Namespace).Get(ctx, token.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, err
+		}
+		existingSecret = nil
+	}
+
+	now := time.Now()
+
+	if existingSecret != nil {
+		if !metav1.IsControlledBy(existingSecret, token) {
+			return reconcile.Result{}, fmt.Errorf("Expected secret '%s' to be controlled by JSONWebToken '%s'", existingSecret.Name, token.Name)
+		}
+
+		if !(GenerateInputs{params}).IsChanged(existingSecret.Annotations) {
+			renewAt, found := r.renewalTime(token)
+			if !found {
+				return reconcile.Result{}, nil
+			}
+			if now.Before(renewAt) {
+				return reconcile.Result{RequeueAfter: jwtRequeueAfter(renewAt, now)}, nil
+			}
+		}
+	}
+
+	claims := map[string]interface{}{"iat": now.Unix()}
+	for k, v := range specClaims {
+		claims[k] = v
+	}
+	if expiresIn > 0 {
+		claims["exp"] = now.Add(expiresIn).Unix()
+	}
+
+	tokenStr, err := signer.Sign(claims)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.saveSecret(ctx, token, existingSecret, params, tokenStr)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	token.Status.KeyID = signer.KeyID
+	token.Status.IssuedAt = &metav1.Time{Time: time.Unix(now.Unix(), 0)}
+	token.Status.ExpiresAt = nil
+
+	if expiresIn > 0 {
+		token.Status.ExpiresAt = &metav1.Time{Time: time.Unix(now.Add(expiresIn).Unix(), 0)}
+
+		renewAt, _ := r.renewalTime(token)
+		return reconcile.Result{RequeueAfter: jwtRequeueAfter(renewAt, now)}, nil
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// jwtRequeueAfter returns delay until renewal. Non-positive RequeueAfter
+// is not scheduled at all hence delay is kept at one second at least.
+func jwtRequeueAfter(renewAt, now time.Time) time.Duration {
+	requeueAfter := renewAt.Sub(now)
+	if requeueAfter < time.Second {
+		requeueAfter = time.Second
+	}
+	return requeueAfter
+}
+
+// renewalTime returns time when two thirds of token lifetime have elapsed.
+// Tokens without expiration never need renewal.
+func (r *JSONWebTokenReconciler) renewalTime(token *sgv1alpha1.JSONWebToken) (time.Time, bool) {
+	if token.Status.IssuedAt == nil || token.Status.ExpiresAt == nil {
+		return time.Time{}, false
+	}
+	issuedAt := token.Status.IssuedAt.Time
+	lifetime := token.Status.ExpiresAt.Sub(issuedAt)
+	return issuedAt.Add(lifetime * 2 / 3), true
+}
+
+func (r *JSONWebTokenReconciler) getSigner(ctx context.Context, token *sgv1alpha1.JSONWebToken) (jwtSigner, error) {
+	keySecret, err := r.coreClient.CoreV1().Secrets(token.Namespace).Get(ctx, token.Spec.SigningKeyRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return jwtSigner{}, fmt.Errorf("Getting signing key secret: %s", err)
+	}
+
+	keyData, found := keySecret.Data[token.SigningKeySecretKey()]
+	if !found || len(keyData) == 0 {
+		return jwtSigner{}, fmt.Errorf("Expected signing key secret '%s' to have '%s' key", keySecret.Name, token.SigningKeySecretKey())
+	}
+
+	if token.Spec.SigningKeyRef.Kind == sgv1alpha1.JSONWebTokenSigningKeyKindPassword {
+		signer, err := newJWTSigner(token.Spec.Algorithm, keyData)
+		if err != nil {
+			return jwtSigner{}, err
+		}
+		signer.KeySecretVersion = string(keySecret.UID) + "/" + keySecret.ResourceVersion
+		return signer, nil
+	}
+
+	privateKey, err := parsePrivateKeyPEM(keyData)
+	if err != nil {
+		return jwtSigner{}, fmt.Errorf("Reading signing key secret '%s': %s", keySecret.Name, err)
+	}
+
+	return newJWTSigner(token.Spec.Algorithm, privateKey)
+}
+
+func (r *JSONWebTokenReconciler) saveSecret(ctx context.Context, token *sgv1alpha1.JSONWebToken,
+	existingSecret *corev1.Secret, params jwtParams, tokenStr string) error {
+
+	values := map[string][]byte{
+		sgv1alpha1.JSONWebTokenSecretTokenKey: []byte(tokenStr),
+	}
+
+	secret := reconciler.NewSecret(token, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.JSONWebTokenSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.JSONWebTokenSecretDefaultTokenKey: expansion.Variable(sgv1alpha1.JSONWebTokenSecretTokenKey),
+		},
+	}
+
+	err := secret.ApplyTemplates(defaultTemplate, token.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = GenerateInputs{params}.Add(newSecret.Annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *JSONWebTokenReconciler) updateStatus(ctx context.Context, token *sgv1alpha1.JSONWebToken) error {
+	existingToken, err := r.sgClient.SecretgenV1alpha1().JSONWebTokens(token.Namespace).Get(ctx, token.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching jsonwebtoken: %s", err)
+	}
+
+	existingToken.Status = token.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().JSONWebTokens(existingToken.Namespace).UpdateStatus(ctx, existingToken, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating jsonwebtoken status: %s", err)
+	}
+
+	return nil
+}
+
+// jwtSigner signs tokens using JWS compact serialization (RFC 7515).
+type jwtSigner struct {
+	Algorithm string
+	// KeyID is JWK thumbprint of public key; empty for HMAC
+	KeyID string
+	// KeySecretVersion is UID and resource version of Secret
+	// holding HMAC key; empty for asymmetric keys
+	KeySecretVersion string
+
+	key interface{}
+}
+
+var (
+	jwtHashes = map[string]crypto.Hash{
+		"HS256": crypto.SHA256, "HS384": crypto.SHA384, "HS512": crypto.SHA512,
+		"RS256": crypto.SHA256, "RS384": crypto.SHA384, "RS512": crypto.SHA512,
+		"ES256": crypto.SHA256, "ES384": crypto.SHA384,
+	}
+)
+
+// newJWTSigner picks default algorithm based on key when it's not specified
+// and makes sure that key is suitable for requested algorithm.
+func newJWTSigner(alg string, key interface{}) (jwtSigner, error) {
+	var defaultAlg string
+	var allowedAlgs []string
+
+	switch typedKey := key.(type) {
+	case []byte:
+		defaultAlg, allowedAlgs = "HS256", []string{"HS256", "HS384", "HS512"}
+
+	case *rsa.PrivateKey:
+		defaultAlg, allowedAlgs = "RS256", []string{"RS256", "RS384", "RS512"}
+
+	case *ecdsa.PrivateKey:
+		switch typedKey.Curve.Params().Name {
+		case "P-256":
+			defaultAlg = "ES256"
+		case "P-384":
+			defaultAlg = "ES384"
+		default:
+			return jwtSigner{}, fmt.Errorf("Unsupported ECDSA curve '%s'", typedKey.Curve.Params().Name)
+		}
+		allowedAlgs = []string{defaultAlg}
+
+	case ed25519.PrivateKey:
+		defaultAlg, allowedAlgs = "EdDSA", []string{"EdDSA"}
+
+	default:
+		return jwtSigner{}, fmt.Errorf("Unsupported signing key type %T", key)
+	}
+
+	if len(alg) == 0 {
+		alg = defaultAlg
+	}
+
+	algAllowed := false
+	for _, allowedAlg := range allowedAlgs {
+		if alg == allowedAlg {
+			algAllowed = true
+		}
+	}
+	if !algAllowed {
+		return jwtSigner{}, fmt.Errorf("Expected algorithm to be one of %v for signing key, but was '%s'", allowedAlgs, alg)
+	}
+
+	signer := jwtSigner{Algorithm: alg, key: key}
+
+	if _, ok := key.([]byte); !ok {
+		_, publicJWK, err := newJWKs(key)
+		if err != nil {
+			return jwtSigner{}, err
+		}
+		signer.KeyID = publicJWK.Kid
+	}
+
+	return signer, nil
+}
+
+func (s jwtSigner) Sign(claims map[string]interface{}) (string, error) {
+	header := map[string]string{"alg": s.Algorithm, "typ": "JWT"}
+	if len(s.KeyID) > 0 {
+		header["kid"] = s.KeyID
+	}
+
+	headerBs, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling header: %s", err)
+	}
+
+	claimsBs, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling claims: %s", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerBs) + "." + base64.RawURLEncoding.EncodeToString(claimsBs)
+
+	var digest []byte
+
+	if hash, found := jwtHashes[s.Algorithm]; found {
+		hasher := hash.New()
+		hasher.Write([]byte(signingInput))
+		digest = hasher.Sum(nil)
+	}
+
+	var sig []byte
+
+	switch typedKey := s.key.(type) {
+	case []byte:
+		mac := hmac.New(jwtHashes[s.Algorithm].New, typedKey)
+		mac.Write([]byte(signingInput))
+		sig = mac.Sum(nil)
+
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, typedKey, jwtHashes[s.Algorithm], digest)
+
+	case *ecdsa.PrivateKey:
+		var sigR, sigS *big.Int
+		sigR, sigS, err = ecdsa.Sign(rand.Reader, typedKey, digest)
+		if err == nil {
+			// JWS uses fixed size R || S encoding instead of ASN.1
+			size := (typedKey.Curve.Params().BitSize + 7) / 8
+			sig = make([]byte, 2*size)
+			sigR.FillBytes(sig[:size])
+			sigS.FillBytes(sig[size:])
+		}
+
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(typedKey, []byte(signingInput))
+
+	default:
+		return "", fmt.Errorf("Unsupported signing key type %T", s.key)
+	}
+	if err != nil {
+		return "", fmt.Errorf("Signing token: %s", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig), nil
+}
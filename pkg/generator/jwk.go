@@ -0,0 +1,156 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+)
+
+// jsonWebKey represents subset of JWK (RFC 7517) members
+// necessary to describe RSA, EC and OKP (Ed25519) keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid,omitempty"`
+	Use string `json:"use,omitempty"`
+	Alg string `json:"alg,omitempty"`
+
+	// RSA public members
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+
+	// EC and OKP public members
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+
+	// Private members
+	D  string `json:"d,omitempty"`
+	P  string `json:"p,omitempty"`
+	Q  string `json:"q,omitempty"`
+	DP string `json:"dp,omitempty"`
+	DQ string `json:"dq,omitempty"`
+	QI string `json:"qi,omitempty"`
+}
+
+// newJWKs returns private and public JWKs for given private key.
+// Both carry the same kid computed as JWK thumbprint (RFC 7638)
+// so that it stays stable for the lifetime of the key.
+func newJWKs(key crypto.PrivateKey) (jsonWebKey, jsonWebKey, error) {
+	var private, public jsonWebKey
+
+	switch typedKey := key.(type) {
+	case *rsa.PrivateKey:
+		public = jsonWebKey{
+			Kty: "RSA",
+			N:   jwkBase64(typedKey.N.Bytes()),
+			E:   jwkBase64(big.NewInt(int64(typedKey.E)).Bytes()),
+		}
+
+		typedKey.Precompute()
+
+		private = public
+		private.D = jwkBase64(typedKey.D.Bytes())
+		private.P = jwkBase64(typedKey.Primes[0].Bytes())
+		private.Q = jwkBase64(typedKey.Primes[1].Bytes())
+		private.DP = jwkBase64(typedKey.Precomputed.Dp.Bytes())
+		private.DQ = jwkBase64(typedKey.Precomputed.Dq.Bytes())
+		private.QI = jwkBase64(typedKey.Precomputed.Qinv.Bytes())
+
+	case *ecdsa.PrivateKey:
+		size := (typedKey.Curve.Params().BitSize + 7) / 8
+
+		public = jsonWebKey{
+			Kty: "EC",
+			Crv: typedKey.Curve.Params().Name,
+			X:   jwkBase64(typedKey.X.FillBytes(make([]byte, size))),
+			Y:   jwkBase64(typedKey.Y.FillBytes(make([]byte, size))),
+		}
+
+		private = public
+		private.D = jwkBase64(typedKey.D.FillBytes(make([]byte, size)))
+
+	case ed25519.PrivateKey:
+		public = jsonWebKey{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   jwkBase64(typedKey.Public().(ed25519.PublicKey)),
+		}
+
+		private = public
+		private.D = jwkBase64(typedKey.Seed())
+
+	default:
+		return jsonWebKey{}, jsonWebKey{}, fmt.Errorf("Unsupported JWK key type %T", key)
+	}
+
+	kid, err := public.Thumbprint()
+	if err != nil {
+		return jsonWebKey{}, jsonWebKey{}, err
+	}
+
+	public.Kid = kid
+	private.Kid = kid
+
+	return private, public, nil
+}
+
+// Thumbprint computes RFC 7638 thumbprint which is a hash
+// of required public members serialized in lexicographic order.
+func (k jsonWebKey) Thumbprint() (string, error) {
+	var members interface{}
+
+	// Anonymous structs guarantee member order during serialization
+	switch k.Kty {
+	case "RSA":
+		members = struct {
+			E   string `json:"e"`
+			Kty string `json:"kty"`
+			N   string `json:"n"`
+		}{k.E, k.Kty, k.N}
+	case "EC":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+			Y   string `json:"y"`
+		}{k.Crv, k.Kty, k.X, k.Y}
+	case "OKP":
+		members = struct {
+			Crv string `json:"crv"`
+			Kty string `json:"kty"`
+			X   string `json:"x"`
+		}{k.Crv, k.Kty, k.X}
+	default:
+		return "", fmt.Errorf("Unsupported JWK key type '%s'", k.Kty)
+	}
+
+	bs, err := json.Marshal(members)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling JWK thumbprint members: %s", err)
+	}
+
+	sum := sha256.Sum256(bs)
+
+	return jwkBase64(sum[:]), nil
+}
+
+func (k jsonWebKey) AsString() (string, error) {
+	bs, err := json.Marshal(k)
+	if err != nil {
+		return "", fmt.Errorf("Marshaling JWK: %s", err)
+	}
+	return string(bs), nil
+}
+
+func jwkBase64(bs []byte) string {
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
@@ -0,0 +1,210 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+type KeyPairReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &KeyPairReconciler{}
+
+func NewKeyPairReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *KeyPairReconciler {
+	return &KeyPairReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *KeyPairReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.KeyPair{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *KeyPairReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	keyPair, err := r.sgClient.SecretgenV1alpha1().KeyPairs(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if keyPair.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		keyPair.Status.GenericStatus,
+		func(st sgv1alpha1.GenericStatus) { keyPair.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(keyPair.ObjectMeta)
+	defer r.updateStatus(ctx, keyPair)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, keyPair))
+}
+
+func (r *KeyPairReconciler) reconcile(ctx context.Context, keyPair *sgv1alpha1.KeyPair) (reconcile.Result, error) {
+	err := keyPair.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	rotation := newRotation(keyPair.ObjectMeta, keyPair.Status.LastRotation)
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(keyPair.Namespace).Get(ctx, keyPair.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.createSecret(ctx, keyPair, nil, rotation)
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() && metav1.IsControlledBy(existingSecret, keyPair) {
+		return r.createSecret(ctx, keyPair, existingSecret, rotation)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// createSecret generates new values and creates Secret
+// or replaces values of existing Secret when rotation is requested.
+func (r *KeyPairReconciler) createSecret(ctx context.Context, keyPair *sgv1alpha1.KeyPair,
+	existingSecret *corev1.Secret, rotation rotation) (reconcile.Result, error) {
+
+	values, err := r.generate(keyPair)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	secret := reconciler.NewSecret(keyPair, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.KeyPairSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.KeyPairSecretDefaultPublicKeyKey:  expansion.Variable(sgv1alpha1.KeyPairSecretPublicKeyKey),
+			sgv1alpha1.KeyPairSecretDefaultPrivateKeyKey: expansion.Variable(sgv1alpha1.KeyPairSecretPrivateKeyKey),
+		},
+	}
+
+	err = secret.ApplyTemplates(defaultTemplate, keyPair.Spec.SecretTemplate)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() {
+		keyPair.Status.LastRotation = rotation.Status()
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *KeyPairReconciler) generate(keyPair *sgv1alpha1.KeyPair) (map[string][]byte, error) {
+	var privateKey crypto.Signer
+	var err error
+
+	switch keyPair.KeyAlgorithm() {
+	case sgv1alpha1.KeyPairAlgorithmECDSA:
+		curve := elliptic.P256()
+		if keyPair.KeyCurve() == sgv1alpha1.KeyPairCurveP384 {
+			curve = elliptic.P384()
+		}
+		privateKey, err = ecdsa.GenerateKey(curve, rand.Reader)
+
+	case sgv1alpha1.KeyPairAlgorithmEd25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+
+	default:
+		return nil, fmt.Errorf("Unsupported algorithm '%s'", keyPair.KeyAlgorithm())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Generating %s key pair: %s", keyPair.KeyAlgorithm(), err)
+	}
+
+	publicKey, err := encodePublicKeyPEM(privateKey.Public())
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPKCS8, err := encodePrivateKeyPKCS8PEM(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateJWK, publicJWK, err := newJWKs(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateJWKStr, err := privateJWK.AsString()
+	if err != nil {
+		return nil, err
+	}
+
+	publicJWKStr, err := publicJWK.AsString()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string][]byte{
+		sgv1alpha1.KeyPairSecretPublicKeyKey:       []byte(publicKey),
+		sgv1alpha1.KeyPairSecretPrivateKeyKey:      []byte(privateKeyPKCS8),
+		sgv1alpha1.KeyPairSecretPrivateKeyPKCS8Key: []byte(privateKeyPKCS8),
+		sgv1alpha1.KeyPairSecretJWKKey:             []byte(privateJWKStr),
+		sgv1alpha1.KeyPairSecretPublicJWKKey:       []byte(publicJWKStr),
+		sgv1alpha1.KeyPairSecretKeyIDKey:           []byte(publicJWK.Kid),
+	}, nil
+}
+
+func (r *KeyPairReconciler) updateStatus(ctx context.Context, keyPair *sgv1alpha1.KeyPair) error {
+	existingKeyPair, err := r.sgClient.SecretgenV1alpha1().KeyPairs(keyPair.Namespace).Get(ctx, keyPair.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching keypair: %s", err)
+	}
+
+	existingKeyPair.Status = keyPair.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().KeyPairs(existingKeyPair.Namespace).UpdateStatus(ctx, existingKeyPair, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating keypair status: %s", err)
+	}
+
+	return nil
+}
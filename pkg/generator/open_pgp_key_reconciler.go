@@ -0,0 +1,311 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+type OpenPGPKeyReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &OpenPGPKeyReconciler{}
+
+// openPGPKeyParams holds spec fields that cause key
+// to be regenerated when changed
+type openPGPKeyParams struct {
+	ExpirySeconds int64
+}
+
+func NewOpenPGPKeyReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *OpenPGPKeyReconciler {
+	return &OpenPGPKeyReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *OpenPGPKeyReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.OpenPGPKey{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *OpenPGPKeyReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	pgpKey, err := r.sgClient.SecretgenV1alpha1().OpenPGPKeys(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if pgpKey.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		pgpKey.Status.GenericStatus,
+		func(st sgv1alpha1.GenericStatus) { pgpKey.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(pgpKey.ObjectMeta)
+	defer r.updateStatus(ctx, pgpKey)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, pgpKey))
+}
+
+func (r *OpenPGPKeyReconciler) reconcile(ctx context.Context, pgpKey *sgv1alpha1.OpenPGPKey) (reconcile.Result, error) {
+	err := pgpKey.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	expiry, err := r.expiry(pgpKey)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	rotation := newRotation(pgpKey.ObjectMeta, pgpKey.Status.LastRotation)
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(pgpKey.Namespace).Get(ctx, pgpKey.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.createSecret(ctx, pgpKey, nil, expiry, rotation)
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if !metav1.IsControlledBy(existingSecret, pgpKey) {
+		return reconcile.Result{}, nil
+	}
+
+	params := openPGPKeyParams{ExpirySeconds: int64(expiry.Seconds())}
+
+	if rotation.IsRequested() || (GenerateInputs{params}).IsChanged(existingSecret.Annotations) {
+		return r.createSecret(ctx, pgpKey, existingSecret, expiry, rotation)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *OpenPGPKeyReconciler) expiry(pgpKey *sgv1alpha1.OpenPGPKey) (time.Duration, error) {
+	if len(pgpKey.Spec.Expiry) == 0 {
+		return 0, nil
+	}
+
+	expiry, err := ParseDuration(pgpKey.Spec.Expiry)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid expiry: %s", err)
+	}
+	// Key lifetime is encoded as 32-bit number of seconds where zero means no expiry
+	if expiry < time.Second || expiry.Seconds() > math.MaxUint32 {
+		return 0, fmt.Errorf("Invalid expiry: expected to be between 1s and %s", time.Duration(math.MaxUint32)*time.Second)
+	}
+
+	return expiry, nil
+}
+
+// createSecret generates new values and creates Secret
+// or replaces values of existing Secret when rotation is requested or expiry changed.
+func (r *OpenPGPKeyReconciler) createSecret(ctx context.Context, pgpKey *sgv1alpha1.OpenPGPKey,
+	existingSecret *corev1.Secret, expiry time.Duration, rotation rotation) (reconcile.Result, error) {
+
+	var passphrase []byte
+
+	if pgpKey.Spec.PassphraseRef != nil {
+		var err error
+
+		passphrase, err = r.getPassphrase(ctx, pgpKey)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+	}
+
+	values, entity, err := r.generate(pgpKey, expiry, passphrase)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	secret := reconciler.NewSecret(pgpKey, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.OpenPGPKeySecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.OpenPGPKeySecretDefaultPrivateKeyKey: expansion.Variable(sgv1alpha1.OpenPGPKeySecretPrivateKeyKey),
+			sgv1alpha1.OpenPGPKeySecretDefaultPublicKeyKey:  expansion.Variable(sgv1alpha1.OpenPGPKeySecretPublicKeyKey),
+		},
+	}
+
+	err = secret.ApplyTemplates(defaultTemplate, pgpKey.Spec.SecretTemplate)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = GenerateInputs{openPGPKeyParams{ExpirySeconds: int64(expiry.Seconds())}}.Add(newSecret.Annotations)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() {
+		pgpKey.Status.LastRotation = rotation.Status()
+	}
+
+	pgpKey.Status.Fingerprint = string(values[sgv1alpha1.OpenPGPKeySecretFingerprintKey])
+	pgpKey.Status.ExpiresAt = nil
+
+	if expiry > 0 {
+		pgpKey.Status.ExpiresAt = &metav1.Time{Time: entity.PrimaryKey.CreationTime.Add(expiry)}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *OpenPGPKeyReconciler) getPassphrase(ctx context.Context, pgpKey *sgv1alpha1.OpenPGPKey) ([]byte, error) {
+	passphraseSecret, err := r.coreClient.CoreV1().Secrets(pgpKey.Namespace).Get(ctx, pgpKey.Spec.PassphraseRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Getting passphrase secret: %s", err)
+	}
+
+	passphrase := passphraseSecret.Data[pgpKey.PassphraseSecretKey()]
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("Expected passphrase secret '%s' to have non-empty '%s' key",
+			passphraseSecret.Name, pgpKey.PassphraseSecretKey())
+	}
+
+	return passphrase, nil
+}
+
+func (r *OpenPGPKeyReconciler) generate(pgpKey *sgv1alpha1.OpenPGPKey,
+	expiry time.Duration, passphrase []byte) (map[string][]byte, *openpgp.Entity, error) {
+
+	config := &packet.Config{
+		DefaultHash:     crypto.SHA256,
+		KeyLifetimeSecs: uint32(expiry.Seconds()),
+	}
+
+	switch pgpKey.KeyAlgorithm() {
+	case sgv1alpha1.OpenPGPKeyAlgorithmRSA:
+		config.Algorithm = packet.PubKeyAlgoRSA
+		config.RSABits = pgpKey.KeyBits()
+
+	case sgv1alpha1.OpenPGPKeyAlgorithmEd25519:
+		// Primary key is Ed25519 and encryption subkey is X25519
+		config.Algorithm = packet.PubKeyAlgoEdDSA
+		config.Curve = packet.Curve25519
+
+	default:
+		return nil, nil, fmt.Errorf("Unsupported algorithm '%s'", pgpKey.KeyAlgorithm())
+	}
+
+	entity, err := openpgp.NewEntity(pgpKey.Spec.Name, pgpKey.Spec.Comment, pgpKey.Spec.Email, config)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Generating OpenPGP key: %s", err)
+	}
+
+	var publicKey bytes.Buffer
+
+	err = r.armor(&publicKey, openpgp.PublicKeyType, entity.Serialize)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Serializing public key: %s", err)
+	}
+
+	var privateKey bytes.Buffer
+
+	if len(passphrase) > 0 {
+		err = entity.EncryptPrivateKeys(passphrase, config)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Encrypting private key: %s", err)
+		}
+	}
+
+	// Self-signatures were made by NewEntity, hence there is no need
+	// to re-sign (which would not be possible with encrypted keys)
+	err = r.armor(&privateKey, openpgp.PrivateKeyType, func(w io.Writer) error {
+		return entity.SerializePrivateWithoutSigning(w, config)
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("Serializing private key: %s", err)
+	}
+
+	return map[string][]byte{
+		sgv1alpha1.OpenPGPKeySecretPrivateKeyKey:  privateKey.Bytes(),
+		sgv1alpha1.OpenPGPKeySecretPublicKeyKey:   publicKey.Bytes(),
+		sgv1alpha1.OpenPGPKeySecretFingerprintKey: []byte(fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint)),
+		sgv1alpha1.OpenPGPKeySecretKeyIDKey:       []byte(entity.PrimaryKey.KeyIdString()),
+	}, entity, nil
+}
+
+func (r *OpenPGPKeyReconciler) armor(buf *bytes.Buffer, blockType string, serializeFunc func(io.Writer) error) error {
+	w, err := armor.Encode(buf, blockType, nil)
+	if err != nil {
+		return err
+	}
+
+	err = serializeFunc(w)
+	if err != nil {
+		return err
+	}
+
+	err = w.Close()
+	if err != nil {
+		return err
+	}
+
+	buf.WriteString("\n")
+	return nil
+}
+
+func (r *OpenPGPKeyReconciler) updateStatus(ctx context.Context, pgpKey *sgv1alpha1.OpenPGPKey) error {
+	existingOpenPGPKey, err := r.sgClient.SecretgenV1alpha1().OpenPGPKeys(pgpKey.Namespace).Get(ctx, pgpKey.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching openpgpkey: %s", err)
+	}
+
+	existingOpenPGPKey.Status = pgpKey.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().OpenPGPKeys(existingOpenPGPKey.Namespace).UpdateStatus(ctx, existingOpenPGPKey, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating openpgpkey status: %s", err)
+	}
+
+	return nil
+}
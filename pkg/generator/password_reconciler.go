@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"math/big"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
 	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -27,18 +29,26 @@ import (
 type PasswordReconciler struct {
 	sgClient   sgclient.Interface
 	coreClient kubernetes.Interface
+	digester   SecretDigester
 	log        logr.Logger
 }
 
 var _ reconcile.Reconciler = &PasswordReconciler{}
 
 func NewPasswordReconciler(sgClient sgclient.Interface,
-	coreClient kubernetes.Interface, log logr.Logger) *PasswordReconciler {
-	return &PasswordReconciler{sgClient, coreClient, log}
+	coreClient kubernetes.Interface, digester SecretDigester, log logr.Logger) *PasswordReconciler {
+	return &PasswordReconciler{sgClient, coreClient, digester, log}
 }
 
 // AttachWatches adds starts watches this reconciler requires.
 func (r *PasswordReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch generated Secrets so that they are repaired when deleted or modified
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForOwner{
+		OwnerType: &sgv1alpha1.Password{}, IsController: true})
+	if err != nil {
+		return err
+	}
+
 	return controller.Watch(&source.Kind{Type: &sgv1alpha1.Password{}}, &handler.EnqueueRequestForObject{})
 }
 
@@ -65,36 +75,31 @@ func (r *PasswordReconciler) Reconcile(ctx context.Context, request reconcile.Re
 		func(st sgv1alpha1.GenericStatus) { password.Status.GenericStatus = st },
 	}
 
+	// Missing Secret after successful reconcile means it was deleted
+	secretExpected := status.IsReconcileSucceeded()
+
 	status.SetReconciling(password.ObjectMeta)
 	defer r.updateStatus(ctx, password)
 
-	return status.WithReconcileCompleted(r.reconcile(ctx, password))
+	return status.WithReconcileCompleted(r.reconcile(ctx, password, secretExpected))
 }
 
-func (r *PasswordReconciler) reconcile(ctx context.Context, password *sgv1alpha1.Password) (reconcile.Result, error) {
-	_, err := r.coreClient.CoreV1().Secrets(password.Namespace).Get(ctx, password.Name, metav1.GetOptions{})
-	if err != nil {
-		if errors.IsNotFound(err) {
-			return r.createSecret(ctx, password)
-		}
-		return reconcile.Result{Requeue: true}, err
-	}
-
-	return reconcile.Result{}, nil
-}
+func (r *PasswordReconciler) reconcile(ctx context.Context,
+	password *sgv1alpha1.Password, secretExpected bool) (reconcile.Result, error) {
 
-func (r *PasswordReconciler) createSecret(ctx context.Context, password *sgv1alpha1.Password) (reconcile.Result, error) {
-	passwordStr, err := r.generate(password)
+	gracePeriod, err := parseRotationGracePeriod(password.Spec.RotationGracePeriod)
 	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
 	}
 
-	values := map[string][]byte{
-		sgv1alpha1.PasswordSecretKey: []byte(passwordStr),
+	existingSecret, err := r.coreClient.CoreV1().Secrets(password.Namespace).Get(ctx, password.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, err
+		}
+		existingSecret = nil
 	}
 
-	secret := reconciler.NewSecret(password, values)
-
 	defaultTemplate := sgv1alpha1.SecretTemplate{
 		Type: sgv1alpha1.PasswordSecretDefaultType,
 		StringData: map[string]string{
@@ -102,19 +107,112 @@ func (r *PasswordReconciler) createSecret(ctx context.Context, password *sgv1alp
 		},
 	}
 
+	var values, replacedValues map[string][]byte
+	var driftStatus *sgv1alpha1.SecretDriftStatus
+
+	rotation := newRotation(password.ObjectMeta, password.Status.LastRotation)
+
+	if existingSecret != nil {
+		if !metav1.IsControlledBy(existingSecret, password) {
+			if !password.Spec.AdoptExisting {
+				// Secret that is not generated by this Password is left as is
+				return reconcile.Result{}, nil
+			}
+
+			// Adopted Secret values are recovered same as generated ones
+			existingSecret, err = adoptSecret(ctx, r.coreClient, password, existingSecret,
+				secretTemplateKeys(defaultTemplate, password.Spec.SecretTemplate))
+			if err != nil {
+				return reconcile.Result{Requeue: true}, err
+			}
+		}
+
+		drift := newSecretDrift(existingSecret, r.digester)
+
+		value, found := drift.RecoverValues(defaultTemplate, password.Spec.SecretTemplate)[sgv1alpha1.PasswordSecretKey]
+
+		if rotation.IsRequested() {
+			// Previously generated values are replaced when rotation is requested
+			if found {
+				replacedValues = map[string][]byte{sgv1alpha1.PasswordSecretKey: value}
+			}
+		} else {
+			if found {
+				values = map[string][]byte{sgv1alpha1.PasswordSecretKey: value}
+			} else if len(drift.DriftedKeys) == 0 {
+				// Password could not be recovered (e.g. used only within larger
+				// template values) but Secret was not modified hence it's kept
+				keptSecret := existingSecret.DeepCopy()
+				err = recordDataDigests(keptSecret, r.digester)
+				if err != nil {
+					return reconcile.Result{Requeue: true}, err
+				}
+				_, err = applySecret(ctx, r.coreClient, existingSecret, keptSecret)
+				if err != nil {
+					return reconcile.Result{Requeue: true}, err
+				}
+				return reconcile.Result{}, nil
+			}
+
+			driftStatus = drift.Status(values != nil)
+		}
+	} else if secretExpected {
+		driftStatus = newSecretDeletedDriftStatus()
+	}
+
+	if values == nil {
+		passwordStr, err := r.generate(password)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+
+		values = map[string][]byte{
+			sgv1alpha1.PasswordSecretKey: []byte(passwordStr),
+		}
+	}
+
+	values, previousExpiresAt, err := previousValues{password, r.coreClient}.Apply(ctx, values, replacedValues, gracePeriod)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	secret := reconciler.NewSecret(password, values)
+
 	err = secret.ApplyTemplates(defaultTemplate, password.Spec.SecretTemplate)
 	if err != nil {
 		return reconcile.Result{Requeue: true}, err
 	}
 
 	newSecret := secret.AsSecret()
+	omitEmptyPreviousValues(newSecret, password.Spec.SecretTemplate)
 
-	_, err = r.coreClient.CoreV1().Secrets(newSecret.Namespace).Create(ctx, newSecret, metav1.CreateOptions{})
+	err = recordDataDigests(newSecret, r.digester)
 	if err != nil {
 		return reconcile.Result{Requeue: true}, err
 	}
 
-	return reconcile.Result{}, nil
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if driftStatus != nil {
+		password.Status.LastSecretDrift = driftStatus
+	}
+
+	if rotation.IsRequested() {
+		password.Status.LastRotation = rotation.Status()
+	}
+
+	if previousExpiresAt.IsZero() {
+		password.Status.PreviousValuesExpirationTimestamp = nil
+		return reconcile.Result{}, nil
+	}
+
+	password.Status.PreviousValuesExpirationTimestamp = &metav1.Time{Time: previousExpiresAt}
+
+	// Reconcile again to drop previous values once they expire
+	return reconcile.Result{RequeueAfter: time.Until(previousExpiresAt)}, nil
 }
 
 func (r *PasswordReconciler) generate(password *sgv1alpha1.Password) (string, error) {
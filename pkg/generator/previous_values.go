@@ -0,0 +1,207 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	PreviousValuesExpiresAtAnnKey = "secretgen.k14s.io/expires-at"
+	// PreviousValuesOfLabelKey holds UID of resource previous values belong to
+	PreviousValuesOfLabelKey = "secretgen.k14s.io/previous-values-of"
+
+	previousValuesSecretSuffix = "-previous-"
+	previousVariablePrefix     = "previous."
+)
+
+// previousValues keeps values that were replaced during rotation in
+// a separate Secret (named <owner>-previous-<random suffix>) for a grace
+// period so that they could be exposed next to new values as previous.*
+// variables. Secret is found via label and owner reference instead of
+// a fixed name so that it never collides with Secrets of other resources.
+type previousValues struct {
+	owner      metav1.Object
+	coreClient kubernetes.Interface
+}
+
+// find returns Secret keeping previous values or nil if there is none.
+// Secrets that carry the label but are not controlled by the owner are ignored.
+func (p previousValues) find(ctx context.Context) (*corev1.Secret, error) {
+	secrets, err := p.coreClient.CoreV1().Secrets(p.owner.GetNamespace()).List(ctx, metav1.ListOptions{
+		LabelSelector: PreviousValuesOfLabelKey + "=" + string(p.owner.GetUID()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Listing previous values secrets: %s", err)
+	}
+
+	for i := range secrets.Items {
+		if metav1.IsControlledBy(&secrets.Items[i], p.owner) {
+			return &secrets.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Save keeps given values (excluding previous.* values) until expiration time
+// replacing values that were kept before.
+func (p previousValues) Save(ctx context.Context, values map[string][]byte, expiresAt time.Time) error {
+	data := map[string][]byte{}
+
+	for name, val := range values {
+		if !strings.HasPrefix(name, previousVariablePrefix) {
+			data[name] = val
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: p.owner.GetName() + previousValuesSecretSuffix,
+			Namespace:    p.owner.GetNamespace(),
+			Labels: map[string]string{
+				PreviousValuesOfLabelKey: string(p.owner.GetUID()),
+			},
+			Annotations: map[string]string{
+				PreviousValuesExpiresAtAnnKey: expiresAt.UTC().Format(time.RFC3339),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: data,
+	}
+
+	err := controllerutil.SetControllerReference(p.owner, secret, scheme.Scheme)
+	if err != nil {
+		return fmt.Errorf("Setting owner of previous values secret: %s", err)
+	}
+
+	existingSecret, err := p.find(ctx)
+	if err != nil {
+		return err
+	}
+	if existingSecret != nil {
+		secret.Name = existingSecret.Name
+	}
+
+	_, err = applySecret(ctx, p.coreClient, existingSecret, secret)
+	if err != nil {
+		return fmt.Errorf("Saving previous values secret: %s", err)
+	}
+
+	return nil
+}
+
+// Load returns kept values and time when they expire. Values that
+// already expired are deleted and nil values are returned.
+func (p previousValues) Load(ctx context.Context) (map[string][]byte, time.Time, error) {
+	secret, err := p.find(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if secret == nil {
+		return nil, time.Time{}, nil
+	}
+
+	// Unparsable expiration time is treated as already expired
+	expiresAt, err := time.Parse(time.RFC3339, secret.Annotations[PreviousValuesExpiresAtAnnKey])
+	if err == nil && time.Now().Before(expiresAt) {
+		return secret.Data, expiresAt, nil
+	}
+
+	err = p.coreClient.CoreV1().Secrets(secret.Namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, time.Time{}, fmt.Errorf("Deleting previous values secret: %s", err)
+	}
+
+	return nil, time.Time{}, nil
+}
+
+// withPreviousValues returns values with previous.* value added for each
+// value. Previous values that are not known are set to empty values
+// (see omitEmptyPreviousValues for how they end up in Secret).
+func withPreviousValues(values, previous map[string][]byte) map[string][]byte {
+	result := map[string][]byte{}
+
+	for name, val := range values {
+		if strings.HasPrefix(name, previousVariablePrefix) {
+			continue
+		}
+
+		result[name] = val
+
+		if val, found := previous[name]; found {
+			result[previousVariablePrefix+name] = val
+		} else {
+			result[previousVariablePrefix+name] = []byte{}
+		}
+	}
+
+	return result
+}
+
+// Apply keeps replaced values (if any) for a grace period and returns
+// values with previous.* values added together with time when previous
+// values expire (zero time if there are no previous values).
+func (p previousValues) Apply(ctx context.Context, values, replacedValues map[string][]byte,
+	gracePeriod time.Duration) (map[string][]byte, time.Time, error) {
+
+	if len(replacedValues) > 0 && gracePeriod > 0 {
+		err := p.Save(ctx, replacedValues, time.Now().Add(gracePeriod))
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	previous, expiresAt, err := p.Load(ctx)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return withPreviousValues(values, previous), expiresAt, nil
+}
+
+// omitEmptyPreviousValues removes Secret keys whose template refers to
+// previous.* variables but which expanded to empty (or whitespace only)
+// value because there are no previous values (e.g. before first rotation
+// or after grace period ended). Otherwise consumers would see empty
+// credential (e.g. empty previous password) as a valid one.
+// Keys that mix previous.* variables with other content are kept.
+func omitEmptyPreviousValues(secret *corev1.Secret, customTpl *sgv1alpha1.SecretTemplate) {
+	if customTpl == nil {
+		return
+	}
+
+	previousRef := "$(" + previousVariablePrefix
+
+	for key, tplVal := range customTpl.StringData {
+		if !strings.Contains(tplVal, previousRef) {
+			continue
+		}
+		if len(strings.TrimSpace(string(secret.Data[key]))) == 0 {
+			delete(secret.Data, key)
+		}
+	}
+}
+
+func parseRotationGracePeriod(gracePeriod string) (time.Duration, error) {
+	if len(gracePeriod) == 0 {
+		return 0, nil
+	}
+	dur, err := ParseDuration(gracePeriod)
+	if err != nil {
+		return 0, fmt.Errorf("Invalid rotationGracePeriod: %s", err)
+	}
+	return dur, nil
+}
@@ -0,0 +1,58 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestOmitEmptyPreviousValues(t *testing.T) {
+	tpl := &sgv1alpha1.SecretTemplate{
+		StringData: map[string]string{
+			"password":          "$(value)",
+			"previous-password": "$(previous.value)",
+			"passwords":         "$(value)\n$(previous.value)",
+		},
+	}
+
+	t.Run("removes keys that only refer to missing previous values", func(t *testing.T) {
+		secret := &corev1.Secret{Data: map[string][]byte{
+			"password":          []byte("new"),
+			"previous-password": []byte(""),
+			"passwords":         []byte("new\n"),
+		}}
+
+		omitEmptyPreviousValues(secret, tpl)
+
+		assert.Equal(t, map[string][]byte{
+			"password":  []byte("new"),
+			"passwords": []byte("new\n"),
+		}, secret.Data)
+	})
+
+	t.Run("keeps keys with previous values", func(t *testing.T) {
+		secret := &corev1.Secret{Data: map[string][]byte{
+			"password":          []byte("new"),
+			"previous-password": []byte("old"),
+			"passwords":         []byte("new\nold"),
+		}}
+
+		omitEmptyPreviousValues(secret, tpl)
+
+		assert.Len(t, secret.Data, 3)
+	})
+
+	t.Run("keeps empty keys that do not refer to previous values", func(t *testing.T) {
+		secret := &corev1.Secret{Data: map[string][]byte{"empty": []byte("")}}
+
+		omitEmptyPreviousValues(secret, &sgv1alpha1.SecretTemplate{StringData: map[string]string{"empty": ""}})
+		omitEmptyPreviousValues(secret, nil)
+
+		assert.Len(t, secret.Data, 1)
+	})
+}
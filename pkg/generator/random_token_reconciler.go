@@ -0,0 +1,201 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+type RandomTokenReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &RandomTokenReconciler{}
+
+func NewRandomTokenReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *RandomTokenReconciler {
+	return &RandomTokenReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *RandomTokenReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.RandomToken{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *RandomTokenReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	randomToken, err := r.sgClient.SecretgenV1alpha1().RandomTokens(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if randomToken.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		randomToken.Status.GenericStatus,
+		func(st sgv1alpha1.GenericStatus) { randomToken.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(randomToken.ObjectMeta)
+	defer r.updateStatus(ctx, randomToken)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, randomToken))
+}
+
+func (r *RandomTokenReconciler) reconcile(ctx context.Context, randomToken *sgv1alpha1.RandomToken) (reconcile.Result, error) {
+	err := randomToken.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	rotation := newRotation(randomToken.ObjectMeta, randomToken.Status.LastRotation)
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(randomToken.Namespace).Get(ctx, randomToken.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.createSecret(ctx, randomToken, nil, rotation)
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() && metav1.IsControlledBy(existingSecret, randomToken) {
+		return r.createSecret(ctx, randomToken, existingSecret, rotation)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// createSecret generates new values and creates Secret
+// or replaces values of existing Secret when rotation is requested.
+func (r *RandomTokenReconciler) createSecret(ctx context.Context, randomToken *sgv1alpha1.RandomToken,
+	existingSecret *corev1.Secret, rotation rotation) (reconcile.Result, error) {
+
+	values, err := r.generate(randomToken)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	secret := reconciler.NewSecret(randomToken, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.RandomTokenSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.RandomTokenSecretDefaultValueKey: expansion.Variable(sgv1alpha1.RandomTokenSecretValueKey),
+		},
+	}
+
+	err = secret.ApplyTemplates(defaultTemplate, randomToken.Spec.SecretTemplate)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() {
+		randomToken.Status.LastRotation = rotation.Status()
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *RandomTokenReconciler) generate(randomToken *sgv1alpha1.RandomToken) (map[string][]byte, error) {
+	bs := make([]byte, randomToken.TokenBytes())
+
+	_, err := rand.Read(bs)
+	if err != nil {
+		return nil, fmt.Errorf("Generating random bytes: %s", err)
+	}
+
+	return newRandomTokenValues(bs, randomToken.TokenEncoding())
+}
+
+// newRandomTokenValues encodes random bytes using all supported encodings,
+// with value being encoded with requested one. base64url and base32
+// are unpadded as they are typically used within URLs and file names.
+func newRandomTokenValues(bs []byte, encoding string) (map[string][]byte, error) {
+	var value string
+
+	if encoding == sgv1alpha1.RandomTokenEncodingUUID {
+		if len(bs) != sgv1alpha1.RandomTokenUUIDBytes {
+			return nil, fmt.Errorf("Expected %d bytes for uuid, but was %d", sgv1alpha1.RandomTokenUUIDBytes, len(bs))
+		}
+		// Version 4 (random) UUID as described in RFC 4122 section 4.4
+		bs = append([]byte{}, bs...)
+		bs[6] = (bs[6] & 0x0f) | 0x40
+		bs[8] = (bs[8] & 0x3f) | 0x80
+		value = fmt.Sprintf("%x-%x-%x-%x-%x", bs[0:4], bs[4:6], bs[6:8], bs[8:10], bs[10:16])
+	}
+
+	values := map[string][]byte{
+		sgv1alpha1.RandomTokenSecretHexKey:       []byte(hex.EncodeToString(bs)),
+		sgv1alpha1.RandomTokenSecretBase64Key:    []byte(base64.StdEncoding.EncodeToString(bs)),
+		sgv1alpha1.RandomTokenSecretBase64URLKey: []byte(base64.RawURLEncoding.EncodeToString(bs)),
+		sgv1alpha1.RandomTokenSecretBase32Key:    []byte(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(bs)),
+	}
+
+	switch encoding {
+	case sgv1alpha1.RandomTokenEncodingUUID:
+		values[sgv1alpha1.RandomTokenSecretValueKey] = []byte(value)
+
+	case sgv1alpha1.RandomTokenEncodingHex, sgv1alpha1.RandomTokenEncodingBase64,
+		sgv1alpha1.RandomTokenEncodingBase64URL, sgv1alpha1.RandomTokenEncodingBase32:
+		values[sgv1alpha1.RandomTokenSecretValueKey] = values[encoding]
+
+	default:
+		return nil, fmt.Errorf("Unsupported encoding '%s'", encoding)
+	}
+
+	return values, nil
+}
+
+func (r *RandomTokenReconciler) updateStatus(ctx context.Context, randomToken *sgv1alpha1.RandomToken) error {
+	existingRandomToken, err := r.sgClient.SecretgenV1alpha1().RandomTokens(randomToken.Namespace).Get(ctx, randomToken.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching randomtoken: %s", err)
+	}
+
+	existingRandomToken.Status = randomToken.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().RandomTokens(existingRandomToken.Namespace).UpdateStatus(ctx, existingRandomToken, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating randomtoken status: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"time"
+
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	RotateAnnKey = "secretgen.carvel.dev/rotate"
+)
+
+// rotation represents on-demand regeneration requested by setting
+// rotate annotation to a new token (e.g. current date or ticket ID).
+// Token is recorded in status once processed so that each token
+// results in exactly one regeneration.
+type rotation struct {
+	token        string
+	lastRotation *sgv1alpha1.RotationStatus
+}
+
+func newRotation(meta metav1.ObjectMeta, lastRotation *sgv1alpha1.RotationStatus) rotation {
+	return rotation{meta.Annotations[RotateAnnKey], lastRotation}
+}
+
+// IsRequested returns true if rotate annotation holds token
+// that has not been processed yet.
+func (r rotation) IsRequested() bool {
+	if len(r.token) == 0 {
+		return false
+	}
+	return r.lastRotation == nil || r.lastRotation.Token != r.token
+}
+
+// Status returns rotation status to be reported once values were regenerated.
+func (r rotation) Status() *sgv1alpha1.RotationStatus {
+	return &sgv1alpha1.RotationStatus{
+		Token:     r.token,
+		RotatedAt: metav1.Time{Time: time.Now().UTC()},
+	}
+}
@@ -5,14 +5,19 @@ package generator
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"time"
 
-	cfgtypes "github.com/cloudfoundry/config-server/types"
 	"github.com/go-logr/logr"
 	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
 	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -25,18 +30,26 @@ import (
 type RSAKeyReconciler struct {
 	sgClient   sgclient.Interface
 	coreClient kubernetes.Interface
+	digester   SecretDigester
 	log        logr.Logger
 }
 
 var _ reconcile.Reconciler = &RSAKeyReconciler{}
 
 func NewRSAKeyReconciler(sgClient sgclient.Interface,
-	coreClient kubernetes.Interface, log logr.Logger) *RSAKeyReconciler {
-	return &RSAKeyReconciler{sgClient, coreClient, log}
+	coreClient kubernetes.Interface, digester SecretDigester, log logr.Logger) *RSAKeyReconciler {
+	return &RSAKeyReconciler{sgClient, coreClient, digester, log}
 }
 
 // AttachWatches adds starts watches this reconciler requires.
 func (r *RSAKeyReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch generated Secrets so that they are repaired when deleted or modified
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForOwner{
+		OwnerType: &sgv1alpha1.RSAKey{}, IsController: true})
+	if err != nil {
+		return err
+	}
+
 	return controller.Watch(&source.Kind{Type: &sgv1alpha1.RSAKey{}}, &handler.EnqueueRequestForObject{})
 }
 
@@ -63,36 +76,36 @@ func (r *RSAKeyReconciler) Reconcile(ctx context.Context, request reconcile.Requ
 		func(st sgv1alpha1.GenericStatus) { rsaKey.Status.GenericStatus = st },
 	}
 
+	// Missing Secret after successful reconcile means it was deleted
+	secretExpected := status.IsReconcileSucceeded()
+
 	status.SetReconciling(rsaKey.ObjectMeta)
 	defer r.updateStatus(ctx, rsaKey)
 
-	return status.WithReconcileCompleted(r.reconcile(ctx, rsaKey))
+	return status.WithReconcileCompleted(r.reconcile(ctx, rsaKey, secretExpected))
 }
 
-func (r *RSAKeyReconciler) reconcile(ctx context.Context, rsaKey *sgv1alpha1.RSAKey) (reconcile.Result, error) {
-	_, err := r.coreClient.CoreV1().Secrets(rsaKey.Namespace).Get(ctx, rsaKey.Name, metav1.GetOptions{})
+func (r *RSAKeyReconciler) reconcile(ctx context.Context,
+	rsaKey *sgv1alpha1.RSAKey, secretExpected bool) (reconcile.Result, error) {
+
+	err := rsaKey.Validate()
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return r.createSecret(ctx, rsaKey)
-		}
-		return reconcile.Result{Requeue: true}, err
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
 	}
-	return reconcile.Result{}, nil
-}
 
-func (r *RSAKeyReconciler) createSecret(ctx context.Context, rsaKey *sgv1alpha1.RSAKey) (reconcile.Result, error) {
-	rsaKeyResult, err := r.generate(rsaKey)
+	gracePeriod, err := parseRotationGracePeriod(rsaKey.Spec.RotationGracePeriod)
 	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
 	}
 
-	values := map[string][]byte{
-		sgv1alpha1.RSAKeySecretPublicKeyKey:  []byte(rsaKeyResult.PublicKey),
-		sgv1alpha1.RSAKeySecretPrivateKeyKey: []byte(rsaKeyResult.PrivateKey),
+	existingSecret, err := r.coreClient.CoreV1().Secrets(rsaKey.Namespace).Get(ctx, rsaKey.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, err
+		}
+		existingSecret = nil
 	}
 
-	secret := reconciler.NewSecret(rsaKey, values)
-
 	defaultTemplate := sgv1alpha1.SecretTemplate{
 		Type: sgv1alpha1.RSAKeySecretDefaultType,
 		StringData: map[string]string{
@@ -101,31 +114,219 @@ func (r *RSAKeyReconciler) createSecret(ctx context.Context, rsaKey *sgv1alpha1.
 		},
 	}
 
+	var values, replacedValues map[string][]byte
+	var driftStatus *sgv1alpha1.SecretDriftStatus
+
+	rotation := newRotation(rsaKey.ObjectMeta, rsaKey.Status.LastRotation)
+
+	if existingSecret != nil {
+		if !metav1.IsControlledBy(existingSecret, rsaKey) {
+			if !rsaKey.Spec.AdoptExisting {
+				// Secret that is not generated by this RSAKey is left as is
+				return reconcile.Result{}, nil
+			}
+
+			// Adopted Secret values are recovered same as generated ones
+			existingSecret, err = adoptSecret(ctx, r.coreClient, rsaKey, existingSecret,
+				secretTemplateKeys(defaultTemplate, rsaKey.Spec.SecretTemplate))
+			if err != nil {
+				return reconcile.Result{Requeue: true}, err
+			}
+		}
+
+		drift := newSecretDrift(existingSecret, r.digester)
+
+		recoveredValues, err := r.recover(drift.RecoverValues(defaultTemplate, rsaKey.Spec.SecretTemplate))
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+
+		if rotation.IsRequested() {
+			// Previously generated values are replaced when rotation is requested
+			replacedValues = recoveredValues
+		} else {
+			values = recoveredValues
+
+			if values == nil && len(drift.DriftedKeys) == 0 {
+				// Private key could not be recovered (e.g. used only within larger
+				// template values) but Secret was not modified hence it's kept
+				keptSecret := existingSecret.DeepCopy()
+				err = recordDataDigests(keptSecret, r.digester)
+				if err != nil {
+					return reconcile.Result{Requeue: true}, err
+				}
+				_, err = applySecret(ctx, r.coreClient, existingSecret, keptSecret)
+				if err != nil {
+					return reconcile.Result{Requeue: true}, err
+				}
+				return reconcile.Result{}, nil
+			}
+
+			driftStatus = drift.Status(values != nil)
+		}
+	} else if secretExpected {
+		driftStatus = newSecretDeletedDriftStatus()
+	}
+
+	if values == nil {
+		values, err = r.generate(rsaKey)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+	}
+
+	values, previousExpiresAt, err := previousValues{rsaKey, r.coreClient}.Apply(ctx, values, replacedValues, gracePeriod)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	secret := reconciler.NewSecret(rsaKey, values)
+
 	err = secret.ApplyTemplates(defaultTemplate, rsaKey.Spec.SecretTemplate)
 	if err != nil {
 		return reconcile.Result{Requeue: true}, err
 	}
 
 	newSecret := secret.AsSecret()
+	omitEmptyPreviousValues(newSecret, rsaKey.Spec.SecretTemplate)
+
+	err = recordDataDigests(newSecret, r.digester)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
 
-	_, err = r.coreClient.CoreV1().Secrets(newSecret.Namespace).Create(ctx, newSecret, metav1.CreateOptions{})
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
 	if err != nil {
 		return reconcile.Result{Requeue: true}, err
 	}
 
-	return reconcile.Result{}, nil
+	if driftStatus != nil {
+		rsaKey.Status.LastSecretDrift = driftStatus
+	}
+
+	if rotation.IsRequested() {
+		rsaKey.Status.LastRotation = rotation.Status()
+	}
+
+	if previousExpiresAt.IsZero() {
+		rsaKey.Status.PreviousValuesExpirationTimestamp = nil
+		return reconcile.Result{}, nil
+	}
+
+	rsaKey.Status.PreviousValuesExpirationTimestamp = &metav1.Time{Time: previousExpiresAt}
+
+	// Reconcile again to drop previous values once they expire
+	return reconcile.Result{RequeueAfter: time.Until(previousExpiresAt)}, nil
 }
 
-func (r *RSAKeyReconciler) generate(rsaKey *sgv1alpha1.RSAKey) (cfgtypes.RSAKey, error) {
-	gen := cfgtypes.NewRSAKeyGenerator()
+// recover rebuilds all values from recovered private key
+// keeping recovered values as is. Returns nil if valid private key
+// is not among recovered values.
+func (r *RSAKeyReconciler) recover(recoveredValues map[string][]byte) (map[string][]byte, error) {
+	for _, key := range []string{sgv1alpha1.RSAKeySecretPrivateKeyKey, sgv1alpha1.RSAKeySecretPrivateKeyPKCS8Key} {
+		privateKeyPEM, found := recoveredValues[key]
+		if !found {
+			continue
+		}
+
+		privateKey, err := parseRSAPrivateKeyPEM(privateKeyPEM)
+		if err != nil {
+			// Treat unparsable key same as key that was not recovered
+			continue
+		}
+
+		values, err := rsaKeyValues(privateKey)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, val := range recoveredValues {
+			values[name] = val
+		}
+
+		return values, nil
+	}
 
-	// TODO allow number of bits?
-	rsaKeyVal, err := gen.Generate(nil)
+	return nil, nil
+}
+
+func (r *RSAKeyReconciler) generate(rsaKey *sgv1alpha1.RSAKey) (map[string][]byte, error) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, rsaKey.KeyBits())
 	if err != nil {
-		return cfgtypes.RSAKey{}, err
+		return nil, fmt.Errorf("Generating RSA key pair: %s", err)
+	}
+
+	return rsaKeyValues(privateKey)
+}
+
+func parseRSAPrivateKeyPEM(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("Expected private key to contain PEM formatted block")
 	}
 
-	return rsaKeyVal.(cfgtypes.RSAKey), nil
+	if block.Type == "RSA PRIVATE KEY" {
+		privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("Parsing private key: %s", err)
+		}
+		return privateKey, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("Parsing private key: %s", err)
+	}
+
+	privateKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("Expected private key to be RSA key")
+	}
+
+	return privateKey, nil
+}
+
+func rsaKeyValues(privateKey *rsa.PrivateKey) (map[string][]byte, error) {
+	publicKey, err := encodePublicKeyPEM(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPKCS8, err := encodePrivateKeyPKCS8PEM(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateJWK, publicJWK, err := newJWKs(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateJWKStr, err := privateJWK.AsString()
+	if err != nil {
+		return nil, err
+	}
+
+	publicJWKStr, err := publicJWK.AsString()
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPKCS1 := pem.EncodeToMemory(&pem.Block{
+		Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+
+	publicKeyPKCS1 := pem.EncodeToMemory(&pem.Block{
+		Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&privateKey.PublicKey)})
+
+	return map[string][]byte{
+		sgv1alpha1.RSAKeySecretPublicKeyKey:       []byte(publicKey),
+		sgv1alpha1.RSAKeySecretPrivateKeyKey:      privateKeyPKCS1,
+		sgv1alpha1.RSAKeySecretPrivateKeyPKCS8Key: []byte(privateKeyPKCS8),
+		sgv1alpha1.RSAKeySecretPublicKeyPKCS1Key:  publicKeyPKCS1,
+		sgv1alpha1.RSAKeySecretJWKKey:             []byte(privateJWKStr),
+		sgv1alpha1.RSAKeySecretPublicJWKKey:       []byte(publicJWKStr),
+		sgv1alpha1.RSAKeySecretKeyIDKey:           []byte(publicJWK.Kid),
+	}, nil
 }
 
 func (r *RSAKeyReconciler) updateStatus(ctx context.Context, rsaKey *sgv1alpha1.RSAKey) error {
@@ -0,0 +1,71 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+// adoptSecret takes ownership of Secret that existed before generating
+// resource was created (e.g. when migrating to secretgen-controller).
+// Secret is only adopted when it contains all expected keys and is
+// not controlled by another resource. Secret data is left as is.
+func adoptSecret(ctx context.Context, coreClient kubernetes.Interface,
+	owner metav1.Object, secret *corev1.Secret, expectedKeys []string) (*corev1.Secret, error) {
+
+	if controller := metav1.GetControllerOf(secret); controller != nil {
+		return nil, fmt.Errorf("Expected secret '%s' to not be controlled by %s '%s' to be adopted",
+			secret.Name, controller.Kind, controller.Name)
+	}
+
+	var missingKeys []string
+
+	for _, key := range expectedKeys {
+		if _, found := secret.Data[key]; !found {
+			missingKeys = append(missingKeys, key)
+		}
+	}
+
+	if len(missingKeys) > 0 {
+		return nil, fmt.Errorf("Expected secret '%s' to contain keys '%s' to be adopted",
+			secret.Name, strings.Join(missingKeys, "', '"))
+	}
+
+	adoptedSecret := secret.DeepCopy()
+
+	err := controllerutil.SetControllerReference(owner, adoptedSecret, scheme.Scheme)
+	if err != nil {
+		return nil, fmt.Errorf("Setting owner of secret: %s", err)
+	}
+
+	adoptedSecret, err = coreClient.CoreV1().Secrets(adoptedSecret.Namespace).Update(ctx, adoptedSecret, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Adopting secret: %s", err)
+	}
+
+	return adoptedSecret, nil
+}
+
+// secretTemplateKeys returns keys of Secret rendered from given templates
+func secretTemplateKeys(defaultTpl sgv1alpha1.SecretTemplate, customTpl *sgv1alpha1.SecretTemplate) []string {
+	var keys []string
+
+	for key := range secretTemplateStringData(defaultTpl, customTpl) {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,119 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	DigestKeySecretName = "secretgen-controller-digest-key"
+
+	digestKeySecretKey = "key"
+	digestKeyBytes     = 32
+)
+
+// SecretDigester computes digests of generated values that are recorded
+// on generated Secrets so that their modifications could be detected.
+// Digests are keyed with a random key that is kept in controller's
+// namespace, hence recorded digests could not be used to check guesses
+// of (possibly low entropy) values offline.
+type SecretDigester struct {
+	key []byte
+}
+
+// NewSecretDigester constructs SecretDigester with given key.
+func NewSecretDigester(key []byte) SecretDigester {
+	return SecretDigester{key}
+}
+
+// NewEphemeralSecretDigester constructs SecretDigester with a random key
+// that is not persisted (e.g. when controller runs outside of a cluster).
+// Digests recorded with it could not be checked after restart.
+func NewEphemeralSecretDigester() (SecretDigester, error) {
+	key := make([]byte, digestKeyBytes)
+
+	_, err := rand.Read(key)
+	if err != nil {
+		return SecretDigester{}, fmt.Errorf("Generating digest key: %s", err)
+	}
+
+	return NewSecretDigester(key), nil
+}
+
+// LoadSecretDigester reads digest key from Secret in given namespace
+// creating that Secret with a random key if it does not exist yet.
+func LoadSecretDigester(ctx context.Context, coreClient kubernetes.Interface, namespace string) (SecretDigester, error) {
+	secrets := coreClient.CoreV1().Secrets(namespace)
+
+	secret, err := secrets.Get(ctx, DigestKeySecretName, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return SecretDigester{}, fmt.Errorf("Getting digest key secret: %s", err)
+		}
+
+		digester, err := NewEphemeralSecretDigester()
+		if err != nil {
+			return SecretDigester{}, err
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      DigestKeySecretName,
+				Namespace: namespace,
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{digestKeySecretKey: digester.key},
+		}
+
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+		if err == nil {
+			return digester, nil
+		}
+		if !errors.IsAlreadyExists(err) {
+			return SecretDigester{}, fmt.Errorf("Creating digest key secret: %s", err)
+		}
+
+		// Key was created concurrently (e.g. by another replica)
+		secret, err = secrets.Get(ctx, DigestKeySecretName, metav1.GetOptions{})
+		if err != nil {
+			return SecretDigester{}, fmt.Errorf("Getting digest key secret: %s", err)
+		}
+	}
+
+	key := secret.Data[digestKeySecretKey]
+	if len(key) < digestKeyBytes {
+		return SecretDigester{}, fmt.Errorf("Expected digest key secret '%s' to have at least %d bytes long '%s' key",
+			DigestKeySecretName, digestKeyBytes, digestKeySecretKey)
+	}
+
+	return NewSecretDigester(key), nil
+}
+
+// KeyID identifies digest key without revealing it so that digests
+// recorded with a different key (or without key) could be recognized.
+func (d SecretDigester) KeyID() string {
+	return d.sum([]byte("key-id"))[:16]
+}
+
+// Digest returns value that changes whenever given value changes.
+func (d SecretDigester) Digest(val []byte) string {
+	return d.sum(append([]byte("value:"), val...))
+}
+
+func (d SecretDigester) sum(msg []byte) string {
+	mac := hmac.New(sha256.New, d.key)
+	mac.Write(msg)
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,26 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/generator"
+)
+
+func TestSecretDigester(t *testing.T) {
+	digester1 := generator.NewSecretDigester([]byte("key1-key1-key1-key1-key1-key1-12"))
+	digester2 := generator.NewSecretDigester([]byte("key2-key2-key2-key2-key2-key2-12"))
+
+	t.Run("digests depend on value", func(t *testing.T) {
+		assert.Equal(t, digester1.Digest([]byte("value")), digester1.Digest([]byte("value")))
+		assert.NotEqual(t, digester1.Digest([]byte("value")), digester1.Digest([]byte("value2")))
+	})
+
+	t.Run("digests depend on key", func(t *testing.T) {
+		assert.NotEqual(t, digester1.Digest([]byte("value")), digester2.Digest([]byte("value")))
+		assert.NotEqual(t, digester1.KeyID(), digester2.KeyID())
+	})
+}
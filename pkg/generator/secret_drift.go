@@ -0,0 +1,151 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	DataDigestsAnnKey = "secretgen.k14s.io/data-digests"
+)
+
+// secretDataDigests is recorded in DataDigestsAnnKey annotation
+type secretDataDigests struct {
+	// KeyID identifies key digests were computed with
+	KeyID   string            `json:"keyID"`
+	Digests map[string]string `json:"digests"`
+}
+
+// secretDrift detects changes made to generated Secret outside of
+// the controller by comparing its data against digests recorded
+// when Secret was last saved.
+type secretDrift struct {
+	// Data that matches recorded digests
+	TrustedData map[string][]byte
+	// Keys that were changed, added or removed
+	DriftedKeys []string
+}
+
+func newSecretDrift(secret *corev1.Secret, digester SecretDigester) secretDrift {
+	drift := secretDrift{TrustedData: map[string][]byte{}}
+
+	var recorded secretDataDigests
+
+	err := json.Unmarshal([]byte(secret.Annotations[DataDigestsAnnKey]), &recorded)
+	if err != nil || recorded.KeyID != digester.KeyID() {
+		// Secrets saved before digests were recorded (or recorded
+		// with a different key, or without one) can not be checked
+		for key, val := range secret.Data {
+			drift.TrustedData[key] = val
+		}
+		return drift
+	}
+
+	digests := recorded.Digests
+
+	for key, val := range secret.Data {
+		if digest, found := digests[key]; found && digest == digester.Digest(val) {
+			drift.TrustedData[key] = val
+		} else {
+			drift.DriftedKeys = append(drift.DriftedKeys, key)
+		}
+	}
+
+	for key := range digests {
+		if _, found := secret.Data[key]; !found {
+			drift.DriftedKeys = append(drift.DriftedKeys, key)
+		}
+	}
+
+	sort.Strings(drift.DriftedKeys)
+
+	return drift
+}
+
+// RecoverValues returns values of variables that were placed as is
+// under trusted Secret keys (e.g. `password: $(value)`) so that Secret
+// could be re-rendered without generating new values.
+func (d secretDrift) RecoverValues(defaultTpl sgv1alpha1.SecretTemplate,
+	customTpl *sgv1alpha1.SecretTemplate) map[string][]byte {
+
+	values := map[string][]byte{}
+
+	for key, tpl := range secretTemplateStringData(defaultTpl, customTpl) {
+		val, found := d.TrustedData[key]
+		if !found {
+			continue
+		}
+		if !strings.HasPrefix(tpl, "$(") || !strings.HasSuffix(tpl, ")") {
+			continue
+		}
+		name := strings.TrimSuffix(strings.TrimPrefix(tpl, "$("), ")")
+		if len(name) > 0 && !strings.ContainsAny(name, "$()") && expansion.Variable(name) == tpl {
+			values[name] = val
+		}
+	}
+
+	return values
+}
+
+// secretTemplateStringData returns data templates Secret is rendered from
+func secretTemplateStringData(defaultTpl sgv1alpha1.SecretTemplate,
+	customTpl *sgv1alpha1.SecretTemplate) map[string]string {
+
+	// Custom template data replaces default template data (see ApplyTemplates)
+	if customTpl != nil && len(customTpl.StringData) > 0 {
+		return customTpl.StringData
+	}
+	return defaultTpl.StringData
+}
+
+// Status returns drift status to be reported or nil if there was no drift.
+func (d secretDrift) Status(valuesPreserved bool) *sgv1alpha1.SecretDriftStatus {
+	if len(d.DriftedKeys) == 0 {
+		return nil
+	}
+	return &sgv1alpha1.SecretDriftStatus{
+		Reason:          sgv1alpha1.SecretDriftReasonModified,
+		Keys:            d.DriftedKeys,
+		ValuesPreserved: valuesPreserved,
+		DetectedAt:      metav1.Time{Time: time.Now().UTC()},
+	}
+}
+
+func newSecretDeletedDriftStatus() *sgv1alpha1.SecretDriftStatus {
+	return &sgv1alpha1.SecretDriftStatus{
+		Reason:     sgv1alpha1.SecretDriftReasonDeleted,
+		DetectedAt: metav1.Time{Time: time.Now().UTC()},
+	}
+}
+
+// recordDataDigests records digests of new Secret data so that
+// later modifications could be detected (see newSecretDrift).
+func recordDataDigests(newSecret *corev1.Secret, digester SecretDigester) error {
+	digests := secretDataDigests{KeyID: digester.KeyID(), Digests: map[string]string{}}
+	for key, val := range newSecret.Data {
+		digests.Digests[key] = digester.Digest(val)
+	}
+
+	digestsBs, err := json.Marshal(digests)
+	if err != nil {
+		return fmt.Errorf("Marshaling data digests: %s", err)
+	}
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	newSecret.Annotations[DataDigestsAnnKey] = string(digestsBs)
+
+	return nil
+}
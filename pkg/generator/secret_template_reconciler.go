@@ -141,18 +141,41 @@ func (r *SecretTemplateReconciler) reconcile(ctx context.Context, secretTemplate
 		},
 	}
 
-	if _, err = controllerutil.CreateOrUpdate(ctx, r.client, &secret, func() error {
+	mutateSecret := func() error {
 		secret.Data = evaluatedTemplateSecret.Data
 		secret.StringData = evaluatedTemplateSecret.StringData
 		secret.ObjectMeta.Annotations = evaluatedTemplateSecret.Annotations
 		secret.ObjectMeta.Labels = evaluatedTemplateSecret.Labels
-
-		// Secret Type is immutable, so cannot be updated. TODO what to do here?
 		secret.Type = evaluatedTemplateSecret.Type
+		secret.Immutable = evaluatedTemplateSecret.Immutable
 
 		return controllerutil.SetControllerReference(secretTemplate, &secret, scheme.Scheme)
-	}); err != nil {
-		return reconcile.Result{}, fmt.Errorf("creating/updating secret: %w", err)
+	}
+
+	existingSecret := corev1.Secret{}
+
+	err = r.client.Get(ctx, client.ObjectKeyFromObject(&secret), &existingSecret)
+	switch {
+	case err == nil && metav1.IsControlledBy(&existingSecret, secretTemplate) &&
+		secretRequiresRecreate(&existingSecret, &evaluatedTemplateSecret):
+		// Secret type and data of immutable Secret cannot be updated
+		if err := r.client.Delete(ctx, &existingSecret); err != nil {
+			return reconcile.Result{}, fmt.Errorf("deleting secret: %w", err)
+		}
+		if err := mutateSecret(); err != nil {
+			return reconcile.Result{}, fmt.Errorf("creating secret: %w", err)
+		}
+		if err := r.client.Create(ctx, &secret); err != nil {
+			return reconcile.Result{}, fmt.Errorf("creating secret: %w", err)
+		}
+
+	case err == nil || errors.IsNotFound(err):
+		if _, err = controllerutil.CreateOrUpdate(ctx, r.client, &secret, mutateSecret); err != nil {
+			return reconcile.Result{}, fmt.Errorf("creating/updating secret: %w", err)
+		}
+
+	default:
+		return reconcile.Result{}, fmt.Errorf("fetching secret: %w", err)
 	}
 
 	secretTemplate.Status.Secret.Name = secret.Name
@@ -306,7 +329,7 @@ func evaluateTemplate(template *sg2v1alpha1.JSONPathTemplate, values map[string]
 		return corev1.Secret{}, fmt.Errorf("templating type: %w", err)
 	}
 
-	return corev1.Secret{
+	secret := corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Labels:      labels,
 			Annotations: annotations,
@@ -314,7 +337,14 @@ func evaluateTemplate(template *sg2v1alpha1.JSONPathTemplate, values map[string]
 		Type:       corev1.SecretType(typeBuffer.String()),
 		StringData: stringData,
 		Data:       data,
-	}, nil
+	}
+
+	if template.Immutable {
+		immutable := true
+		secret.Immutable = &immutable
+	}
+
+	return secret, nil
 }
 
 func evaluate(mapping map[string]string, values map[string]interface{}) (map[string]string, error) {
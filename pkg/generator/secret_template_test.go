@@ -451,6 +451,52 @@ func Test_SecretTemplate(t *testing.T) {
 	}
 }
 
+func Test_SecretTemplate_Immutable(t *testing.T) {
+	template := sg2v1alpha1.SecretTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "secretTemplate",
+			Namespace: "test",
+		},
+		Spec: sg2v1alpha1.SecretTemplateSpec{
+			InputResources: []sg2v1alpha1.InputResource{{
+				Name: "creds",
+				Ref: sg2v1alpha1.InputResourceRef{
+					APIVersion: "v1",
+					Kind:       "Secret",
+					Name:       "existingSecret",
+				},
+			}},
+			JSONPathTemplate: &sg2v1alpha1.JSONPathTemplate{
+				Data: map[string]string{
+					"key1": "$( .creds.data.inputKey1 )",
+				},
+				Immutable: true,
+			},
+		},
+	}
+
+	truthy := true
+
+	generatedSecret := secret("secretTemplate", map[string]string{"key1": "old-value"})
+	generatedSecret.OwnerReferences = []metav1.OwnerReference{secretTemplateOwnerRef("secretTemplate")}
+	generatedSecret.Immutable = &truthy
+
+	secretTemplateReconciler, k8sClient := newReconciler(
+		secret("existingSecret", map[string]string{"inputKey1": "new-value"}), generatedSecret, &template)
+
+	_, err := reconcileObject(t, secretTemplateReconciler, &template)
+	require.NoError(t, err)
+
+	var actualSecret corev1.Secret
+	err = k8sClient.Get(context.Background(), namespacedNameFor(generatedSecret), &actualSecret)
+	require.NoError(t, err)
+
+	assert.Equal(t, &truthy, actualSecret.Immutable)
+	assert.Equal(t, map[string][]byte{"key1": []byte("new-value")}, actualSecret.Data)
+	// Secret was recreated instead of updated
+	assert.Equal(t, "1", actualSecret.ResourceVersion)
+}
+
 func Test_SecretTemplate_Errors(t *testing.T) {
 	type test struct {
 		name            string
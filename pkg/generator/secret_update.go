@@ -0,0 +1,126 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// applySecret creates new Secret or updates existing Secret to match new Secret
+// and returns saved Secret. Labels and annotations of new Secret are merged
+// into existing ones so that metadata added by others (e.g. deploy tools)
+// is kept. Secret is recreated when changes can not be applied via update
+// (see secretRequiresRecreate).
+func applySecret(ctx context.Context, coreClient kubernetes.Interface,
+	existingSecret *corev1.Secret, newSecret *corev1.Secret) (*corev1.Secret, error) {
+
+	secrets := coreClient.CoreV1().Secrets(newSecret.Namespace)
+
+	if existingSecret == nil {
+		createdSecret, err := secrets.Create(ctx, newSecret, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("Creating secret: %s", err)
+		}
+		return createdSecret, nil
+	}
+
+	mergedSecret := newSecret.DeepCopy()
+	mergedSecret.Labels = mergeSecretMetadata(existingSecret.Labels, newSecret.Labels)
+	mergedSecret.Annotations = mergeSecretMetadata(existingSecret.Annotations, newSecret.Annotations)
+
+	if secretRequiresRecreate(existingSecret, newSecret) {
+		err := secrets.Delete(ctx, existingSecret.Name, metav1.DeleteOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("Deleting secret: %s", err)
+		}
+		createdSecret, err := secrets.Create(ctx, mergedSecret, metav1.CreateOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("Creating secret: %s", err)
+		}
+		return createdSecret, nil
+	}
+
+	updatedSecret := existingSecret.DeepCopy()
+	updatedSecret.Labels = mergedSecret.Labels
+	updatedSecret.Annotations = mergedSecret.Annotations
+	updatedSecret.Data = newSecret.Data
+	updatedSecret.StringData = newSecret.StringData
+	updatedSecret.Immutable = newSecret.Immutable
+
+	if reflect.DeepEqual(existingSecret, updatedSecret) {
+		return existingSecret, nil
+	}
+
+	updatedSecret, err := secrets.Update(ctx, updatedSecret, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Updating secret: %s", err)
+	}
+
+	return updatedSecret, nil
+}
+
+// mergeSecretMetadata returns labels or annotations of existing Secret
+// overridden by ones of new Secret.
+func mergeSecretMetadata(existing, new map[string]string) map[string]string {
+	if len(existing) == 0 && len(new) == 0 {
+		return new
+	}
+	merged := map[string]string{}
+	for key, val := range existing {
+		merged[key] = val
+	}
+	for key, val := range new {
+		merged[key] = val
+	}
+	return merged
+}
+
+// secretRequiresRecreate returns true if existing Secret has to be
+// deleted and created again to match new Secret since API server
+// rejects changes to Secret type, data of immutable Secrets and
+// making immutable Secret mutable again.
+func secretRequiresRecreate(existingSecret, newSecret *corev1.Secret) bool {
+	if secretType(existingSecret) != secretType(newSecret) {
+		return true
+	}
+	if !isSecretImmutable(existingSecret) {
+		return false
+	}
+	if !isSecretImmutable(newSecret) {
+		return true
+	}
+
+	newData := map[string][]byte{}
+	for key, val := range newSecret.Data {
+		newData[key] = val
+	}
+	// StringData is merged into data by API server
+	for key, val := range newSecret.StringData {
+		newData[key] = []byte(val)
+	}
+
+	if len(existingSecret.Data) == 0 && len(newData) == 0 {
+		return false
+	}
+
+	return !reflect.DeepEqual(existingSecret.Data, newData)
+}
+
+func isSecretImmutable(secret *corev1.Secret) bool {
+	return secret.Immutable != nil && *secret.Immutable
+}
+
+func secretType(secret *corev1.Secret) corev1.SecretType {
+	if len(secret.Type) == 0 {
+		// Defaulted by API server
+		return corev1.SecretTypeOpaque
+	}
+	return secret.Type
+}
@@ -0,0 +1,333 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	authv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// TokenRequest API does not issue tokens valid for less than 10 minutes
+	saKubeconfigMinExpiration = 10 * time.Minute
+)
+
+// ServiceAccountKubeconfigReconciler produces kubeconfig for ServiceAccount
+// using tokens obtained via TokenRequest API.
+type ServiceAccountKubeconfigReconciler struct {
+	sgClient     sgclient.Interface
+	coreClient   kubernetes.Interface
+	tokenManager TokenManager
+	saTracker    Tracker
+	log          logr.Logger
+}
+
+var _ reconcile.Reconciler = &ServiceAccountKubeconfigReconciler{}
+
+// NewServiceAccountKubeconfigReconciler constructs ServiceAccountKubeconfigReconciler.
+func NewServiceAccountKubeconfigReconciler(sgClient sgclient.Interface, coreClient kubernetes.Interface,
+	tokenManager TokenManager, saTracker Tracker, log logr.Logger) *ServiceAccountKubeconfigReconciler {
+	return &ServiceAccountKubeconfigReconciler{sgClient, coreClient, tokenManager, saTracker, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *ServiceAccountKubeconfigReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch generated Secrets so that they are recreated when deleted
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForOwner{
+		OwnerType: &sgv1alpha1.ServiceAccountKubeconfig{}, IsController: true})
+	if err != nil {
+		return err
+	}
+
+	// Watch ServiceAccounts so that token is reissued when ServiceAccount is recreated
+	err = controller.Watch(&source.Kind{Type: &corev1.ServiceAccount{}}, handler.EnqueueRequestsFromMapFunc(
+		func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			saKey := types.NamespacedName{Namespace: a.GetNamespace(), Name: a.GetName()}
+			for _, tracking := range r.saTracker.GetTracking(saKey) {
+				requests = append(requests, reconcile.Request{NamespacedName: tracking})
+			}
+			return requests
+		},
+	))
+	if err != nil {
+		return err
+	}
+
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.ServiceAccountKubeconfig{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *ServiceAccountKubeconfigReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	kubeconfig, err := r.sgClient.SecretgenV1alpha1().ServiceAccountKubeconfigs(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			r.saTracker.UntrackAll(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if kubeconfig.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          kubeconfig.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { kubeconfig.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(kubeconfig.ObjectMeta)
+	defer r.updateStatus(ctx, kubeconfig)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, kubeconfig))
+}
+
+// saKubeconfigParams are recorded in generate inputs annotation
+// hence changing them results in token being reissued.
+type saKubeconfigParams struct {
+	ServiceAccountName string
+	ServiceAccountUID  types.UID
+	ExpirationSeconds  int64
+	Audiences          []string
+	Server             string
+	CACertDigest       string
+	ClusterName        string
+}
+
+func (r *ServiceAccountKubeconfigReconciler) reconcile(ctx context.Context,
+	kubeconfig *sgv1alpha1.ServiceAccountKubeconfig) (reconcile.Result, error) {
+
+	err := kubeconfig.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	expiration := kubeconfig.Spec.Expiration
+	if len(expiration) == 0 {
+		expiration = sgv1alpha1.ServiceAccountKubeconfigDefaultExpiration
+	}
+
+	expirationDur, err := ParseDuration(expiration)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: fmt.Errorf("Invalid expiration: %s", err)}
+	}
+	if expirationDur < saKubeconfigMinExpiration {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{
+			Err: fmt.Errorf("Invalid expiration: expected to be at least %s", saKubeconfigMinExpiration)}
+	}
+
+	kubeconfigKey := types.NamespacedName{Namespace: kubeconfig.Namespace, Name: kubeconfig.Name}
+
+	r.saTracker.UntrackAll(kubeconfigKey)
+	r.saTracker.Track(kubeconfigKey, types.NamespacedName{
+		Namespace: kubeconfig.Namespace, Name: kubeconfig.Spec.ServiceAccountName})
+
+	sa, err := r.coreClient.CoreV1().ServiceAccounts(kubeconfig.Namespace).Get(ctx, kubeconfig.Spec.ServiceAccountName, metav1.GetOptions{})
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Getting service account: %s", err)
+	}
+
+	// ServiceAccount watch triggers another reconcile once annotation is added
+	if sa.Annotations[sgv1alpha1.ServiceAccountKubeconfigAllowedAnnKey] != "true" {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: fmt.Errorf(
+			"Expected service account '%s' to have annotation '%s' set to 'true' to allow generating kubeconfig",
+			sa.Name, sgv1alpha1.ServiceAccountKubeconfigAllowedAnnKey)}
+	}
+
+	server, caCert, err := apiServerHostAndCACert()
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Getting API server configuration: %s", err)
+	}
+
+	if len(kubeconfig.Spec.Server) > 0 {
+		server = kubeconfig.Spec.Server
+	}
+
+	params := saKubeconfigParams{
+		ServiceAccountName: sa.Name,
+		ServiceAccountUID:  sa.UID,
+		ExpirationSeconds:  int64(expirationDur.Seconds()),
+		Audiences:          kubeconfig.Spec.Audiences,
+		Server:             server,
+		CACertDigest:       fmt.Sprintf("%x", sha256.Sum256(caCert)),
+		ClusterName:        kubeconfig.KubeconfigClusterName(),
+	}
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(kubeconfig.Namespace).Get(ctx, kubeconfig.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, fmt.Errorf("Getting secret: %s", err)
+		}
+		existingSecret = nil
+	}
+
+	now := time.Now()
+
+	if existingSecret != nil {
+		if !metav1.IsControlledBy(existingSecret, kubeconfig) {
+			return reconcile.Result{}, fmt.Errorf("Expected secret '%s' to be controlled by ServiceAccountKubeconfig '%s'", existingSecret.Name, kubeconfig.Name)
+		}
+
+		refreshAt, found := r.refreshTime(kubeconfig)
+		if found && now.Before(refreshAt) && !(GenerateInputs{params}).IsChanged(existingSecret.Annotations) {
+			return reconcile.Result{RequeueAfter: refreshAt.Sub(now)}, nil
+		}
+	}
+
+	tokenRequest, err := r.tokenManager.GetServiceAccountToken(ctx, kubeconfig.Namespace, sa.Name, &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{
+			ExpirationSeconds: &params.ExpirationSeconds,
+			Audiences:         params.Audiences,
+		},
+	})
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Requesting token: %s", err)
+	}
+
+	values, err := newSAKubeconfigValues(params, kubeconfig.Namespace, caCert, tokenRequest.Status.Token)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.saveSecret(ctx, kubeconfig, existingSecret, params, values)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	// API server may shorten requested expiration hence
+	// rely on returned token request to determine issue time
+	expiresAt := tokenRequest.Status.ExpirationTimestamp.Time
+	issuedAt := now
+	if tokenRequest.Spec.ExpirationSeconds != nil {
+		issuedAt = expiresAt.Add(-time.Duration(*tokenRequest.Spec.ExpirationSeconds) * time.Second)
+	}
+
+	kubeconfig.Status.IssuedAt = &metav1.Time{Time: issuedAt}
+	kubeconfig.Status.ExpirationTimestamp = &metav1.Time{Time: expiresAt}
+
+	refreshAt, _ := r.refreshTime(kubeconfig)
+
+	return reconcile.Result{RequeueAfter: refreshAt.Sub(now)}, nil
+}
+
+// refreshTime returns time when two thirds of token lifetime have elapsed
+// so that it's refreshed well before consumers would start seeing it as expired.
+func (r *ServiceAccountKubeconfigReconciler) refreshTime(kubeconfig *sgv1alpha1.ServiceAccountKubeconfig) (time.Time, bool) {
+	if kubeconfig.Status.IssuedAt == nil || kubeconfig.Status.ExpirationTimestamp == nil {
+		return time.Time{}, false
+	}
+	issuedAt := kubeconfig.Status.IssuedAt.Time
+	lifetime := kubeconfig.Status.ExpirationTimestamp.Sub(issuedAt)
+	return issuedAt.Add(lifetime * 2 / 3), true
+}
+
+// newSAKubeconfigValues returns values available to Secret template
+// including complete kubeconfig with a single cluster, user and context.
+func newSAKubeconfigValues(params saKubeconfigParams, namespace string,
+	caCert []byte, token string) (map[string][]byte, error) {
+
+	contextName := params.ServiceAccountName + "@" + params.ClusterName
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[params.ClusterName] = &clientcmdapi.Cluster{
+		Server:                   params.Server,
+		CertificateAuthorityData: caCert,
+	}
+	config.AuthInfos[params.ServiceAccountName] = &clientcmdapi.AuthInfo{
+		Token: token,
+	}
+	config.Contexts[contextName] = &clientcmdapi.Context{
+		Cluster:   params.ClusterName,
+		AuthInfo:  params.ServiceAccountName,
+		Namespace: namespace,
+	}
+	config.CurrentContext = contextName
+
+	configBs, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, fmt.Errorf("Marshaling kubeconfig: %s", err)
+	}
+
+	return map[string][]byte{
+		sgv1alpha1.ServiceAccountKubeconfigSecretKubeconfigKey:         configBs,
+		sgv1alpha1.ServiceAccountKubeconfigSecretTokenKey:              []byte(token),
+		sgv1alpha1.ServiceAccountKubeconfigSecretServerKey:             []byte(params.Server),
+		sgv1alpha1.ServiceAccountKubeconfigSecretCACertKey:             caCert,
+		sgv1alpha1.ServiceAccountKubeconfigSecretNamespaceKey:          []byte(namespace),
+		sgv1alpha1.ServiceAccountKubeconfigSecretServiceAccountNameKey: []byte(params.ServiceAccountName),
+	}, nil
+}
+
+func (r *ServiceAccountKubeconfigReconciler) saveSecret(ctx context.Context, kubeconfig *sgv1alpha1.ServiceAccountKubeconfig,
+	existingSecret *corev1.Secret, params saKubeconfigParams, values map[string][]byte) error {
+
+	secret := reconciler.NewSecret(kubeconfig, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.ServiceAccountKubeconfigSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.ServiceAccountKubeconfigSecretDefaultKubeconfigKey: expansion.Variable(sgv1alpha1.ServiceAccountKubeconfigSecretKubeconfigKey),
+		},
+	}
+
+	err := secret.ApplyTemplates(defaultTemplate, kubeconfig.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = GenerateInputs{params}.Add(newSecret.Annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *ServiceAccountKubeconfigReconciler) updateStatus(ctx context.Context, kubeconfig *sgv1alpha1.ServiceAccountKubeconfig) error {
+	existingKubeconfig, err := r.sgClient.SecretgenV1alpha1().ServiceAccountKubeconfigs(kubeconfig.Namespace).Get(ctx, kubeconfig.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching serviceaccountkubeconfig: %s", err)
+	}
+
+	existingKubeconfig.Status = kubeconfig.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().ServiceAccountKubeconfigs(existingKubeconfig.Namespace).UpdateStatus(ctx, existingKubeconfig, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating serviceaccountkubeconfig status: %s", err)
+	}
+
+	return nil
+}
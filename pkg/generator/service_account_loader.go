@@ -43,7 +43,7 @@ func (s *ServiceAccountLoader) Client(ctx context.Context, saName, saNamespace s
 }
 
 func (s *ServiceAccountLoader) restConfig(ctx context.Context, saName, saNamespace string) (*rest.Config, error) {
-	cfg, err := ctrl.GetConfig()
+	host, caData, err := apiServerHostAndCACert()
 	if err != nil {
 		return nil, err
 	}
@@ -58,13 +58,8 @@ func (s *ServiceAccountLoader) restConfig(ctx context.Context, saName, saNamespa
 		return nil, err
 	}
 
-	caData, err := getCACert(cfg)
-	if err != nil {
-		return nil, err
-	}
-
 	return &rest.Config{
-		Host: cfg.Host,
+		Host: host,
 		TLSClientConfig: rest.TLSClientConfig{
 			CAData: caData,
 		},
@@ -72,6 +67,22 @@ func (s *ServiceAccountLoader) restConfig(ctx context.Context, saName, saNamespa
 	}, nil
 }
 
+// apiServerHostAndCACert returns API server address and CA certificate
+// used by the controller so that clients could reach the same API server.
+func apiServerHostAndCACert() (string, []byte, error) {
+	cfg, err := ctrl.GetConfig()
+	if err != nil {
+		return "", nil, err
+	}
+
+	caData, err := getCACert(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return cfg.Host, caData, nil
+}
+
 func getCACert(cfg *rest.Config) ([]byte, error) {
 	var caData []byte
 	var err error
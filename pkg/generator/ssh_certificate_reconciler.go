@@ -0,0 +1,384 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+var (
+	// sshCertificateDefaultUserExtensions match defaults used by ssh-keygen
+	sshCertificateDefaultUserExtensions = map[string]string{
+		"permit-X11-forwarding":   "",
+		"permit-agent-forwarding": "",
+		"permit-port-forwarding":  "",
+		"permit-pty":              "",
+		"permit-user-rc":          "",
+	}
+)
+
+// SSHCertificateReconciler issues OpenSSH certificates signed by SSHKey.
+type SSHCertificateReconciler struct {
+	sgClient      sgclient.Interface
+	coreClient    kubernetes.Interface
+	secretTracker Tracker
+	log           logr.Logger
+}
+
+var _ reconcile.Reconciler = &SSHCertificateReconciler{}
+
+// NewSSHCertificateReconciler constructs SSHCertificateReconciler.
+func NewSSHCertificateReconciler(sgClient sgclient.Interface, coreClient kubernetes.Interface,
+	secretTracker Tracker, log logr.Logger) *SSHCertificateReconciler {
+	return &SSHCertificateReconciler{sgClient, coreClient, secretTracker, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *SSHCertificateReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch CA Secrets so that certificates are reissued when CA changes
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+		func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			secretKey := types.NamespacedName{Namespace: a.GetNamespace(), Name: a.GetName()}
+			for _, tracking := range r.secretTracker.GetTracking(secretKey) {
+				requests = append(requests, reconcile.Request{NamespacedName: tracking})
+			}
+			return requests
+		},
+	))
+	if err != nil {
+		return err
+	}
+
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.SSHCertificate{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *SSHCertificateReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	sshCert, err := r.sgClient.SecretgenV1alpha1().SSHCertificates(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			r.secretTracker.UntrackAll(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if sshCert.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          sshCert.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { sshCert.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(sshCert.ObjectMeta)
+	defer r.updateStatus(ctx, sshCert)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, sshCert))
+}
+
+// sshCertParams are recorded in generate inputs annotation
+// hence changing them results in certificate being reissued.
+type sshCertParams struct {
+	CAName          string
+	CAFingerprint   string
+	CertType        string
+	KeyID           string
+	Principals      []string
+	Validity        time.Duration
+	NotBeforeSkew   time.Duration
+	CriticalOptions map[string]string
+	Extensions      map[string]string
+	KeyType         string
+	KeyBits         int
+}
+
+func newSSHCertParams(sshCert *sgv1alpha1.SSHCertificate) (sshCertParams, error) {
+	params := sshCertParams{
+		CAName:          sshCert.Spec.CARef.Name,
+		CertType:        sshCert.CertificateType(),
+		KeyID:           sshCert.KeyID(),
+		Principals:      sshCert.Spec.Principals,
+		CriticalOptions: sshCert.Spec.CriticalOptions,
+		Extensions:      sshCert.Spec.Extensions,
+		KeyType:         sshCert.KeyType(),
+		KeyBits:         sshCert.KeyBits(),
+	}
+
+	if params.Extensions == nil && params.CertType == sgv1alpha1.SSHCertificateTypeUser {
+		params.Extensions = sshCertificateDefaultUserExtensions
+	}
+
+	validity := sshCert.Spec.Validity
+	if len(validity) == 0 {
+		validity = sgv1alpha1.SSHCertificateDefaultValidity
+	}
+
+	var err error
+
+	params.Validity, err = ParseDuration(validity)
+	if err != nil {
+		return sshCertParams{}, fmt.Errorf("Invalid validity: %s", err)
+	}
+	if params.Validity == 0 {
+		return sshCertParams{}, fmt.Errorf("Invalid validity: expected to be greater than zero")
+	}
+
+	if len(sshCert.Spec.NotBeforeSkew) > 0 {
+		params.NotBeforeSkew, err = ParseDuration(sshCert.Spec.NotBeforeSkew)
+		if err != nil {
+			return sshCertParams{}, fmt.Errorf("Invalid notBeforeSkew: %s", err)
+		}
+	}
+
+	return params, nil
+}
+
+func (r *SSHCertificateReconciler) reconcile(ctx context.Context, sshCert *sgv1alpha1.SSHCertificate) (reconcile.Result, error) {
+	err := sshCert.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	params, err := newSSHCertParams(sshCert)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	sshCertKey := types.NamespacedName{Namespace: sshCert.Namespace, Name: sshCert.Name}
+	caSecretKey := types.NamespacedName{Namespace: sshCert.Namespace, Name: sshCert.Spec.CARef.Name}
+
+	r.secretTracker.UntrackAll(sshCertKey)
+	r.secretTracker.Track(sshCertKey, caSecretKey)
+
+	caSigner, err := r.getCASigner(ctx, sshCert)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	// Reissue certificates when CA changes
+	params.CAFingerprint = ssh.FingerprintSHA256(caSigner.PublicKey())
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(sshCert.Namespace).Get(ctx, sshCert.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, err
+		}
+		existingSecret = nil
+	}
+
+	now := time.Now()
+
+	if existingSecret != nil {
+		if !metav1.IsControlledBy(existingSecret, sshCert) {
+			return reconcile.Result{}, fmt.Errorf("Expected secret '%s' to be controlled by SSHCertificate '%s'", existingSecret.Name, sshCert.Name)
+		}
+
+		renewAt, found := r.renewalTime(sshCert)
+		if found && now.Before(renewAt) && !(GenerateInputs{params}).IsChanged(existingSecret.Annotations) {
+			return reconcile.Result{RequeueAfter: renewAt.Sub(now)}, nil
+		}
+	}
+
+	cert, values, err := r.issue(params, caSigner, now)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.saveSecret(ctx, sshCert, existingSecret, params, values)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	sshCert.Status.Serial = int64(cert.Serial)
+	sshCert.Status.Fingerprint = ssh.FingerprintSHA256(cert.Key)
+	sshCert.Status.ValidAfter = &metav1.Time{Time: time.Unix(int64(cert.ValidAfter), 0)}
+	sshCert.Status.ValidBefore = &metav1.Time{Time: time.Unix(int64(cert.ValidBefore), 0)}
+
+	renewAt, _ := r.renewalTime(sshCert)
+
+	return reconcile.Result{RequeueAfter: renewAt.Sub(now)}, nil
+}
+
+// renewalTime returns time when two thirds of certificate lifetime have elapsed
+// so that it's reissued well before consumers would start seeing it as expired.
+func (r *SSHCertificateReconciler) renewalTime(sshCert *sgv1alpha1.SSHCertificate) (time.Time, bool) {
+	if sshCert.Status.ValidAfter == nil || sshCert.Status.ValidBefore == nil {
+		return time.Time{}, false
+	}
+	validAfter := sshCert.Status.ValidAfter.Time
+	lifetime := sshCert.Status.ValidBefore.Sub(validAfter)
+	return validAfter.Add(lifetime * 2 / 3), true
+}
+
+func (r *SSHCertificateReconciler) getCASigner(ctx context.Context, sshCert *sgv1alpha1.SSHCertificate) (ssh.Signer, error) {
+	caSecret, err := r.coreClient.CoreV1().Secrets(sshCert.Namespace).Get(ctx, sshCert.Spec.CARef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Getting CA secret: %s", err)
+	}
+
+	caKey, found := caSecret.Data[sgv1alpha1.SSHKeySecretDefaultPrivateKeyKey]
+	if !found {
+		return nil, fmt.Errorf("Expected CA secret '%s' to have '%s' key", caSecret.Name, sgv1alpha1.SSHKeySecretDefaultPrivateKeyKey)
+	}
+
+	caSigner, err := ssh.ParsePrivateKey(caKey)
+	if err != nil {
+		return nil, fmt.Errorf("Parsing CA private key: %s", err)
+	}
+
+	return caSigner, nil
+}
+
+func (r *SSHCertificateReconciler) issue(params sshCertParams, caSigner ssh.Signer,
+	now time.Time) (*ssh.Certificate, map[string][]byte, error) {
+
+	privateKey, err := generateSSHKey(params.KeyType, params.KeyBits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyResult, err := newSSHKeyResult(privateKey, params.KeyID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey, err := ssh.NewPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, nil, fmt.Errorf("Building public key: %s", err)
+	}
+
+	serialBs := make([]byte, 8)
+
+	_, err = rand.Read(serialBs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Generating serial: %s", err)
+	}
+
+	certType := uint32(ssh.UserCert)
+	if params.CertType == sgv1alpha1.SSHCertificateTypeHost {
+		certType = ssh.HostCert
+	}
+
+	cert := &ssh.Certificate{
+		Key:             publicKey,
+		Serial:          sshCertificateSerial(serialBs),
+		CertType:        certType,
+		KeyId:           params.KeyID,
+		ValidPrincipals: params.Principals,
+		ValidAfter:      uint64(now.Add(-params.NotBeforeSkew).Unix()),
+		ValidBefore:     uint64(now.Add(params.Validity).Unix()),
+		Permissions: ssh.Permissions{
+			CriticalOptions: params.CriticalOptions,
+			Extensions:      params.Extensions,
+		},
+	}
+
+	err = cert.SignCert(rand.Reader, caSigner)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Signing certificate: %s", err)
+	}
+
+	caPublicKey := string(ssh.MarshalAuthorizedKey(caSigner.PublicKey()))
+
+	// known_hosts entry trusting host certificates signed by CA
+	hostPattern := "*"
+	if params.CertType == sgv1alpha1.SSHCertificateTypeHost {
+		hostPattern = strings.Join(params.Principals, ",")
+	}
+
+	values := map[string][]byte{
+		sgv1alpha1.SSHCertificateSecretPrivateKeyKey:    []byte(keyResult.PrivateKey),
+		sgv1alpha1.SSHCertificateSecretAuthorizedKeyKey: []byte(keyResult.AuthorizedKey),
+		sgv1alpha1.SSHCertificateSecretCertificateKey:   ssh.MarshalAuthorizedKey(cert),
+		sgv1alpha1.SSHCertificateSecretCAPublicKeyKey:   []byte(caPublicKey),
+		sgv1alpha1.SSHCertificateSecretCAKnownHostsKey:  []byte("@cert-authority " + hostPattern + " " + caPublicKey),
+	}
+
+	return cert, values, nil
+}
+
+// sshCertificateSerial builds serial from random bytes clearing the top bit
+// since status (and CRD schema) represent serial as a signed 64-bit integer
+func sshCertificateSerial(randomBs []byte) uint64 {
+	return binary.BigEndian.Uint64(randomBs) & math.MaxInt64
+}
+
+func (r *SSHCertificateReconciler) saveSecret(ctx context.Context, sshCert *sgv1alpha1.SSHCertificate,
+	existingSecret *corev1.Secret, params sshCertParams, values map[string][]byte) error {
+
+	secret := reconciler.NewSecret(sshCert, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.SSHCertificateSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.SSHCertificateSecretDefaultPrivateKeyKey:  expansion.Variable(sgv1alpha1.SSHCertificateSecretPrivateKeyKey),
+			sgv1alpha1.SSHCertificateSecretDefaultCertificateKey: expansion.Variable(sgv1alpha1.SSHCertificateSecretCertificateKey),
+			sgv1alpha1.SSHCertificateSecretDefaultCAPublicKeyKey: expansion.Variable(sgv1alpha1.SSHCertificateSecretCAPublicKeyKey),
+		},
+	}
+
+	err := secret.ApplyTemplates(defaultTemplate, sshCert.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = GenerateInputs{params}.Add(newSecret.Annotations)
+	if err != nil {
+		return err
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *SSHCertificateReconciler) updateStatus(ctx context.Context, sshCert *sgv1alpha1.SSHCertificate) error {
+	existingSSHCert, err := r.sgClient.SecretgenV1alpha1().SSHCertificates(sshCert.Namespace).Get(ctx, sshCert.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching sshcertificate: %s", err)
+	}
+
+	existingSSHCert.Status = sshCert.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().SSHCertificates(existingSSHCert.Namespace).UpdateStatus(ctx, existingSSHCert, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating sshcertificate status: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSHCertificateSerial(t *testing.T) {
+	t.Run("clears top bit so that serial fits into status", func(t *testing.T) {
+		serial := sshCertificateSerial([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff})
+		assert.Equal(t, uint64(math.MaxInt64), serial)
+		assert.Equal(t, int64(math.MaxInt64), int64(serial))
+
+		serial = sshCertificateSerial([]byte{0x80, 0, 0, 0, 0, 0, 0, 0x01})
+		assert.Equal(t, uint64(1), serial)
+	})
+
+	t.Run("keeps lower 63 bits", func(t *testing.T) {
+		serial := sshCertificateSerial([]byte{0x12, 0x34, 0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0})
+		assert.Equal(t, uint64(0x123456789abcdef0), serial)
+	})
+}
@@ -5,18 +5,32 @@ package generator
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
 	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
 
-	cfgtypes "github.com/cloudfoundry/config-server/types"
 	"github.com/go-logr/logr"
 	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
 	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
 	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
@@ -25,18 +39,26 @@ import (
 type SSHKeyReconciler struct {
 	sgClient   sgclient.Interface
 	coreClient kubernetes.Interface
+	digester   SecretDigester
 	log        logr.Logger
 }
 
 var _ reconcile.Reconciler = &SSHKeyReconciler{}
 
 func NewSSHKeyReconciler(sgClient sgclient.Interface,
-	coreClient kubernetes.Interface, log logr.Logger) *SSHKeyReconciler {
-	return &SSHKeyReconciler{sgClient, coreClient, log}
+	coreClient kubernetes.Interface, digester SecretDigester, log logr.Logger) *SSHKeyReconciler {
+	return &SSHKeyReconciler{sgClient, coreClient, digester, log}
 }
 
 // AttachWatches adds starts watches this reconciler requires.
 func (r *SSHKeyReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch generated Secrets so that they are repaired when deleted or modified
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForOwner{
+		OwnerType: &sgv1alpha1.SSHKey{}, IsController: true})
+	if err != nil {
+		return err
+	}
+
 	return controller.Watch(&source.Kind{Type: &sgv1alpha1.SSHKey{}}, &handler.EnqueueRequestForObject{})
 }
 
@@ -63,69 +85,387 @@ func (r *SSHKeyReconciler) Reconcile(ctx context.Context, request reconcile.Requ
 		func(st sgv1alpha1.GenericStatus) { sshKey.Status.GenericStatus = st },
 	}
 
+	// Missing Secret after successful reconcile means it was deleted
+	secretExpected := status.IsReconcileSucceeded()
+
 	status.SetReconciling(sshKey.ObjectMeta)
 	defer r.updateStatus(ctx, sshKey)
 
-	return status.WithReconcileCompleted(r.reconcile(ctx, sshKey))
+	return status.WithReconcileCompleted(r.reconcile(ctx, sshKey, secretExpected))
 }
 
-func (r *SSHKeyReconciler) reconcile(ctx context.Context, sshKey *sgv1alpha1.SSHKey) (reconcile.Result, error) {
-	_, err := r.coreClient.CoreV1().Secrets(sshKey.Namespace).Get(ctx, sshKey.Name, metav1.GetOptions{})
+func (r *SSHKeyReconciler) reconcile(ctx context.Context,
+	sshKey *sgv1alpha1.SSHKey, secretExpected bool) (reconcile.Result, error) {
+
+	err := sshKey.Validate()
 	if err != nil {
-		if errors.IsNotFound(err) {
-			return r.createSecret(ctx, sshKey)
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	gracePeriod, err := parseRotationGracePeriod(sshKey.Spec.RotationGracePeriod)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	secret, err := r.coreClient.CoreV1().Secrets(sshKey.Namespace).Get(ctx, sshKey.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{Requeue: true}, err
+		}
+		secret = nil
+	}
+
+	if secret != nil && !metav1.IsControlledBy(secret, sshKey) && sshKey.Spec.AdoptExisting {
+		// Adopted Secret values are recovered same as generated ones
+		secret, err = adoptSecret(ctx, r.coreClient, sshKey, secret,
+			secretTemplateKeys(sshKeyDefaultSecretTemplate(), sshKey.Spec.SecretTemplate))
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+	}
+
+	if secret == nil || metav1.IsControlledBy(secret, sshKey) {
+		// Secret that is not generated by this SSHKey is left as is
+		secret, err = r.saveSecret(ctx, sshKey, secret, secretExpected, gracePeriod)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
 		}
-		return reconcile.Result{Requeue: true}, err
 	}
+
+	if sshKey.Spec.KnownHosts != nil {
+		err = r.reconcileKnownHosts(ctx, sshKey, secret)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+	}
+
+	if expiresAt := sshKey.Status.PreviousValuesExpirationTimestamp; expiresAt != nil {
+		// Reconcile again to drop previous values once they expire
+		return reconcile.Result{RequeueAfter: time.Until(expiresAt.Time)}, nil
+	}
+
 	return reconcile.Result{}, nil
 }
 
-func (r *SSHKeyReconciler) createSecret(ctx context.Context, sshKey *sgv1alpha1.SSHKey) (reconcile.Result, error) {
-	sshKeyResult, err := r.generate(sshKey)
-	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+// saveSecret creates Secret or repairs it when it was modified
+// keeping previously generated key when it could be recovered.
+func (r *SSHKeyReconciler) saveSecret(ctx context.Context, sshKey *sgv1alpha1.SSHKey,
+	existingSecret *corev1.Secret, secretExpected bool, gracePeriod time.Duration) (*corev1.Secret, error) {
+
+	defaultTemplate := sshKeyDefaultSecretTemplate()
+
+	var keyResult sshKeyResult
+	var recovered bool
+	var driftStatus *sgv1alpha1.SecretDriftStatus
+	var replacedValues map[string][]byte
+
+	rotation := newRotation(sshKey.ObjectMeta, sshKey.Status.LastRotation)
+
+	if existingSecret != nil {
+		drift := newSecretDrift(existingSecret, r.digester)
+
+		keyResult, recovered = r.recover(sshKey, drift.RecoverValues(defaultTemplate, sshKey.Spec.SecretTemplate))
+
+		if rotation.IsRequested() {
+			// Previously generated values are replaced when rotation is requested
+			if recovered {
+				replacedValues = keyResult.Values()
+			}
+			recovered = false
+		} else {
+			if !recovered && len(drift.DriftedKeys) == 0 {
+				// Private key could not be recovered (e.g. used only within larger
+				// template values) but Secret was not modified hence it's kept
+				keptSecret := existingSecret.DeepCopy()
+				err := recordDataDigests(keptSecret, r.digester)
+				if err != nil {
+					return nil, err
+				}
+				return applySecret(ctx, r.coreClient, existingSecret, keptSecret)
+			}
+
+			driftStatus = drift.Status(recovered)
+		}
+	} else if secretExpected {
+		driftStatus = newSecretDeletedDriftStatus()
 	}
 
-	values := map[string][]byte{
-		sgv1alpha1.SSHKeySecretPrivateKeyKey:    []byte(sshKeyResult.PrivateKey),
-		sgv1alpha1.SSHKeySecretAuthorizedKeyKey: []byte(sshKeyResult.PublicKey),
+	if !recovered {
+		var err error
+
+		keyResult, err = r.generate(sshKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	values, previousExpiresAt, err := previousValues{sshKey, r.coreClient}.Apply(ctx, keyResult.Values(), replacedValues, gracePeriod)
+	if err != nil {
+		return nil, err
 	}
 
 	secret := reconciler.NewSecret(sshKey, values)
 
-	defaultTemplate := sgv1alpha1.SecretTemplate{
+	err = secret.ApplyTemplates(defaultTemplate, sshKey.Spec.SecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	newSecret := secret.AsSecret()
+	omitEmptyPreviousValues(newSecret, sshKey.Spec.SecretTemplate)
+
+	err = recordDataDigests(newSecret, r.digester)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSecret, err := applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return nil, err
+	}
+
+	sshKey.Status.Fingerprint = keyResult.Fingerprint
+
+	if driftStatus != nil {
+		sshKey.Status.LastSecretDrift = driftStatus
+	}
+
+	if rotation.IsRequested() {
+		sshKey.Status.LastRotation = rotation.Status()
+	}
+
+	if previousExpiresAt.IsZero() {
+		sshKey.Status.PreviousValuesExpirationTimestamp = nil
+	} else {
+		sshKey.Status.PreviousValuesExpirationTimestamp = &metav1.Time{Time: previousExpiresAt}
+	}
+
+	return savedSecret, nil
+}
+
+func sshKeyDefaultSecretTemplate() sgv1alpha1.SecretTemplate {
+	return sgv1alpha1.SecretTemplate{
 		Type: sgv1alpha1.SSHKeySecretDefaultType,
 		StringData: map[string]string{
 			sgv1alpha1.SSHKeySecretDefaultPrivateKeyKey:    expansion.Variable(sgv1alpha1.SSHKeySecretPrivateKeyKey),
 			sgv1alpha1.SSHKeySecretDefaultAuthorizedKeyKey: expansion.Variable(sgv1alpha1.SSHKeySecretAuthorizedKeyKey),
 		},
 	}
+}
 
-	err = secret.ApplyTemplates(defaultTemplate, sshKey.Spec.SecretTemplate)
+// recover rebuilds key result from recovered private key keeping
+// private key as is (marshaling OpenSSH keys is not deterministic).
+func (r *SSHKeyReconciler) recover(sshKey *sgv1alpha1.SSHKey,
+	recoveredValues map[string][]byte) (sshKeyResult, bool) {
+
+	privateKeyPEM, found := recoveredValues[sgv1alpha1.SSHKeySecretPrivateKeyKey]
+	if !found {
+		return sshKeyResult{}, false
+	}
+
+	key, err := ssh.ParseRawPrivateKey(privateKeyPEM)
 	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+		return sshKeyResult{}, false
 	}
 
-	newSecret := secret.AsSecret()
+	privateKey, ok := key.(crypto.Signer)
+	if !ok {
+		return sshKeyResult{}, false
+	}
 
-	_, err = r.coreClient.CoreV1().Secrets(newSecret.Namespace).Create(ctx, newSecret, metav1.CreateOptions{})
+	result, err := newSSHKeyResult(privateKey, sshKey.Spec.Comment)
 	if err != nil {
-		return reconcile.Result{Requeue: true}, err
+		return sshKeyResult{}, false
 	}
 
-	return reconcile.Result{}, nil
+	result.PrivateKey = string(privateKeyPEM)
+
+	return result, true
 }
 
-func (r *SSHKeyReconciler) generate(sshKey *sgv1alpha1.SSHKey) (cfgtypes.SSHKey, error) {
-	gen := cfgtypes.NewSSHKeyGenerator()
+// reconcileKnownHosts publishes known_hosts entry for a public key found in
+// generated Secret. Secret is consulted (instead of keeping public key
+// around) since key is only generated once.
+func (r *SSHKeyReconciler) reconcileKnownHosts(ctx context.Context,
+	sshKey *sgv1alpha1.SSHKey, secret *corev1.Secret) error {
+
+	publicKey, err := sshPublicKeyFromSecret(secret, sshKey.Status.Fingerprint)
+	if err != nil {
+		return err
+	}
+
+	knownHosts := newKnownHostsLine(sshKey.KnownHostsPatterns(), publicKey)
+	data := map[string]string{sgv1alpha1.SSHKeyKnownHostsConfigMapKey: knownHosts}
+
+	configMaps := r.coreClient.CoreV1().ConfigMaps(sshKey.Namespace)
+
+	existingConfigMap, err := configMaps.Get(ctx, sshKey.KnownHostsConfigMapName(), metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting config map: %s", err)
+		}
+
+		configMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      sshKey.KnownHostsConfigMapName(),
+				Namespace: sshKey.Namespace,
+				Labels:    sshKey.Labels,
+			},
+			Data: data,
+		}
+
+		err = controllerutil.SetControllerReference(sshKey, configMap, scheme.Scheme)
+		if err != nil {
+			return fmt.Errorf("Setting owner of config map: %s", err)
+		}
+
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("Creating config map: %s", err)
+		}
+		return nil
+	}
+
+	if !metav1.IsControlledBy(existingConfigMap, sshKey) {
+		return fmt.Errorf("Expected config map '%s' to be controlled by SSHKey '%s'", existingConfigMap.Name, sshKey.Name)
+	}
+
+	if reflect.DeepEqual(existingConfigMap.Data, data) {
+		return nil
+	}
+
+	existingConfigMap.Data = data
 
-	// TODO allow type and number of bits?
-	sshKeyVal, err := gen.Generate(nil)
+	_, err = configMaps.Update(ctx, existingConfigMap, metav1.UpdateOptions{})
 	if err != nil {
-		return cfgtypes.SSHKey{}, err
+		return fmt.Errorf("Updating config map: %s", err)
+	}
+
+	return nil
+}
+
+// sshPublicKeyFromSecret finds public key regardless of how Secret was
+// laid out by secret template. Private keys are preferred over
+// authorized keys as they cannot be mistaken for other keys. Key
+// matching given fingerprint (of currently generated key) is preferred.
+func sshPublicKeyFromSecret(secret *corev1.Secret, fingerprint string) (ssh.PublicKey, error) {
+	var keys []string
+	for key := range secret.Data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var publicKeys []ssh.PublicKey
+
+	for _, key := range keys {
+		signer, err := ssh.ParsePrivateKey(secret.Data[key])
+		if err == nil {
+			publicKeys = append(publicKeys, signer.PublicKey())
+		}
+	}
+
+	for _, key := range keys {
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey(secret.Data[key])
+		if err == nil {
+			publicKeys = append(publicKeys, publicKey)
+		}
+	}
+
+	if len(publicKeys) == 0 {
+		return nil, fmt.Errorf("Expected secret '%s' to contain SSH private key or authorized key", secret.Name)
+	}
+
+	// Secret may also contain previous key (see previous.* variables)
+	for _, publicKey := range publicKeys {
+		if ssh.FingerprintSHA256(publicKey) == fingerprint {
+			return publicKey, nil
+		}
+	}
+
+	return publicKeys[0], nil
+}
+
+func newKnownHostsLine(patterns []string, publicKey ssh.PublicKey) string {
+	return strings.Join(patterns, ",") + " " + string(ssh.MarshalAuthorizedKey(publicKey))
+}
+
+type sshKeyResult struct {
+	PrivateKey    string
+	AuthorizedKey string
+	Fingerprint   string
+}
+
+func (r sshKeyResult) Values() map[string][]byte {
+	return map[string][]byte{
+		sgv1alpha1.SSHKeySecretPrivateKeyKey:    []byte(r.PrivateKey),
+		sgv1alpha1.SSHKeySecretAuthorizedKeyKey: []byte(r.AuthorizedKey),
+		sgv1alpha1.SSHKeySecretFingerprintKey:   []byte(r.Fingerprint),
+	}
+}
+
+func (r *SSHKeyReconciler) generate(sshKey *sgv1alpha1.SSHKey) (sshKeyResult, error) {
+	privateKey, err := generateSSHKey(sshKey.KeyType(), sshKey.KeyBits())
+	if err != nil {
+		return sshKeyResult{}, err
+	}
+
+	return newSSHKeyResult(privateKey, sshKey.Spec.Comment)
+}
+
+func generateSSHKey(keyType string, bits int) (crypto.Signer, error) {
+	var privateKey crypto.Signer
+	var err error
+
+	switch keyType {
+	case sgv1alpha1.SSHKeyTypeRSA:
+		privateKey, err = rsa.GenerateKey(rand.Reader, bits)
+
+	case sgv1alpha1.SSHKeyTypeECDSA:
+		var curve elliptic.Curve
+		switch bits {
+		case 384:
+			curve = elliptic.P384()
+		case 521:
+			curve = elliptic.P521()
+		default:
+			curve = elliptic.P256()
+		}
+		privateKey, err = ecdsa.GenerateKey(curve, rand.Reader)
+
+	case sgv1alpha1.SSHKeyTypeEd25519:
+		_, privateKey, err = ed25519.GenerateKey(rand.Reader)
+
+	default:
+		return nil, fmt.Errorf("Unsupported key type '%s'", keyType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Generating %s key: %s", keyType, err)
+	}
+
+	return privateKey, nil
+}
+
+func newSSHKeyResult(privateKey crypto.Signer, comment string) (sshKeyResult, error) {
+	privateKeyBlock, err := ssh.MarshalPrivateKey(privateKey, comment)
+	if err != nil {
+		return sshKeyResult{}, fmt.Errorf("Marshaling private key: %s", err)
+	}
+
+	publicKey, err := ssh.NewPublicKey(privateKey.Public())
+	if err != nil {
+		return sshKeyResult{}, fmt.Errorf("Building public key: %s", err)
+	}
+
+	authorizedKey := string(ssh.MarshalAuthorizedKey(publicKey))
+	if len(comment) > 0 {
+		authorizedKey = strings.TrimSuffix(authorizedKey, "\n") + " " + comment + "\n"
 	}
 
-	return sshKeyVal.(cfgtypes.SSHKey), nil
+	return sshKeyResult{
+		PrivateKey:    string(pem.EncodeToMemory(privateKeyBlock)),
+		AuthorizedKey: authorizedKey,
+		Fingerprint:   ssh.FingerprintSHA256(publicKey),
+	}, nil
 }
 
 func (r *SSHKeyReconciler) updateStatus(ctx context.Context, sshKey *sgv1alpha1.SSHKey) error {
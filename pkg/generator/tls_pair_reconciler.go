@@ -0,0 +1,466 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sg2v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen2/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	sg2client "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client2/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// TLSPairReconciler generates a private CA, server and client certificates
+// for mutual TLS and optionally exports client certificates to other namespaces.
+type TLSPairReconciler struct {
+	sgClient   sgclient.Interface
+	sg2Client  sg2client.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &TLSPairReconciler{}
+
+// NewTLSPairReconciler constructs TLSPairReconciler.
+func NewTLSPairReconciler(sgClient sgclient.Interface, sg2Client sg2client.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *TLSPairReconciler {
+	return &TLSPairReconciler{sgClient, sg2Client, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *TLSPairReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.TLSPair{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *TLSPairReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	pair, err := r.sgClient.SecretgenV1alpha1().TLSPairs(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if pair.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          pair.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { pair.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(pair.ObjectMeta)
+	defer r.updateStatus(ctx, pair)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, pair))
+}
+
+// tlsPairLeaf describes a single server or client certificate of a TLSPair.
+type tlsPairLeaf struct {
+	SecretName     string
+	Params         certParams
+	SecretTemplate *sgv1alpha1.SecretTemplate
+}
+
+func (r *TLSPairReconciler) reconcile(ctx context.Context, pair *sgv1alpha1.TLSPair) (reconcile.Result, error) {
+	err := pair.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	leaves, err := newTLSPairLeaves(pair)
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	caSecret, err := r.reconcileCA(ctx, pair)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	for _, leaf := range leaves {
+		err := r.reconcileLeaf(ctx, pair, caSecret, leaf)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+	}
+
+	err = r.reconcileExports(ctx, pair)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.pruneClientSecrets(ctx, pair)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func newTLSPairLeaves(pair *sgv1alpha1.TLSPair) ([]tlsPairLeaf, error) {
+	serverCN := pair.Spec.Server.CommonName
+	if len(serverCN) == 0 {
+		serverCN = pair.Name
+		if len(pair.Spec.Server.AlternativeNames) > 0 {
+			serverCN = pair.Spec.Server.AlternativeNames[0]
+		}
+	}
+
+	serverParams, err := newTLSPairCertParams(pair, certParams{
+		CommonName:       serverCN,
+		AlternativeNames: pair.Spec.Server.AlternativeNames,
+		ExtKeyUsage:      []string{"server_auth"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := []tlsPairLeaf{{
+		SecretName:     pair.ServerSecretName(),
+		Params:         serverParams,
+		SecretTemplate: pair.Spec.Server.SecretTemplate,
+	}}
+
+	for _, client := range pair.Spec.Clients {
+		clientCN := client.CommonName
+		if len(clientCN) == 0 {
+			clientCN = client.Name
+		}
+
+		clientParams, err := newTLSPairCertParams(pair, certParams{
+			CommonName:  clientCN,
+			ExtKeyUsage: []string{"client_auth"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		leaves = append(leaves, tlsPairLeaf{
+			SecretName:     pair.ClientSecretName(client),
+			Params:         clientParams,
+			SecretTemplate: client.SecretTemplate,
+		})
+	}
+
+	return leaves, nil
+}
+
+func newTLSPairCertParams(pair *sgv1alpha1.TLSPair, params certParams) (certParams, error) {
+	params.Organization = pair.Spec.Organization
+	params.Duration = pair.Spec.Duration
+
+	if len(params.Organization) == 0 {
+		params.Organization = "secretgen"
+	}
+
+	if !params.IsCA {
+		// Since loader is built with only one cert,
+		// name is not relevant, but needs to present
+		params.CAName = "unused-but-not-empty"
+	}
+
+	err := params.setValidity(pair.Spec.Validity, pair.Spec.NotBeforeSkew)
+	if err != nil {
+		return certParams{}, err
+	}
+
+	return params, nil
+}
+
+func (r *TLSPairReconciler) reconcileCA(ctx context.Context, pair *sgv1alpha1.TLSPair) (*corev1.Secret, error) {
+	params, err := newTLSPairCertParams(pair, certParams{
+		CommonName: pair.CASecretName(),
+		IsCA:       true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	existingSecret, err := r.getSecret(ctx, pair, pair.CASecretName())
+	if err != nil {
+		return nil, err
+	}
+	if existingSecret != nil && !r.isReissueNeeded(pair, existingSecret, GenerateInputs{params}) {
+		return existingSecret, nil
+	}
+
+	certResult, err := certGenerator{}.Generate(params)
+	if err != nil {
+		return nil, fmt.Errorf("Generating CA certificate: %s", err)
+	}
+
+	values, err := certResult.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	secret := reconciler.NewSecretWithName(pair, pair.CASecretName(), values)
+
+	// CA Secret uses the same layout as Certificate Secret
+	// so that it could be referenced via Certificate's caRef
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.CertificateSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.CertificateSecretDefaultCertificateKey: expansion.Variable(sgv1alpha1.CertificateSecretCertificateKey),
+			sgv1alpha1.CertificateSecretDefaultPrivateKeyKey:  expansion.Variable(sgv1alpha1.CertificateSecretPrivateKeyKey),
+		},
+	}
+
+	return r.saveSecret(ctx, existingSecret, secret, defaultTemplate, nil, GenerateInputs{params})
+}
+
+func (r *TLSPairReconciler) reconcileLeaf(ctx context.Context, pair *sgv1alpha1.TLSPair,
+	caSecret *corev1.Secret, leaf tlsPairLeaf) error {
+
+	// Leaf certificates are reissued when CA changes
+	inputs := GenerateInputs{tlsPairLeafInputs{
+		Params:          leaf.Params,
+		CACertificateID: fmt.Sprintf("%x", sha256.Sum256(caSecret.Data[sgv1alpha1.CertificateSecretDefaultCertificateKey])),
+	}}
+
+	existingSecret, err := r.getSecret(ctx, pair, leaf.SecretName)
+	if err != nil {
+		return err
+	}
+	if existingSecret != nil && !r.isReissueNeeded(pair, existingSecret, inputs) {
+		return nil
+	}
+
+	gen, err := newCertGenerator(caSecret)
+	if err != nil {
+		return err
+	}
+
+	// Leaf certificates are requested with the same validity as CA
+	// (which was issued earlier) hence let them be capped by CA's lifetime
+	gen.capToCA = true
+
+	certResult, err := gen.Generate(leaf.Params)
+	if err != nil {
+		return fmt.Errorf("Generating certificate for secret '%s': %s", leaf.SecretName, err)
+	}
+
+	values, err := certResult.Values()
+	if err != nil {
+		return err
+	}
+
+	values[sgv1alpha1.TLSPairSecretCAKey] = []byte(certResult.CAPEM())
+
+	secret := reconciler.NewSecretWithName(pair, leaf.SecretName, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.TLSPairSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.TLSPairSecretDefaultCertificateKey: expansion.Variable(sgv1alpha1.TLSPairSecretCertificateKey),
+			sgv1alpha1.TLSPairSecretDefaultPrivateKeyKey:  expansion.Variable(sgv1alpha1.TLSPairSecretPrivateKeyKey),
+			sgv1alpha1.TLSPairSecretDefaultCAKey:          expansion.Variable(sgv1alpha1.TLSPairSecretCAKey),
+		},
+	}
+
+	_, err = r.saveSecret(ctx, existingSecret, secret, defaultTemplate, leaf.SecretTemplate, inputs)
+	return err
+}
+
+// tlsPairLeafInputs identifies inputs of a leaf certificate
+// including CA certificate that signed it.
+type tlsPairLeafInputs struct {
+	Params          certParams
+	CACertificateID string
+}
+
+// getSecret returns nil if Secret does not exist.
+func (r *TLSPairReconciler) getSecret(ctx context.Context, pair *sgv1alpha1.TLSPair, name string) (*corev1.Secret, error) {
+	secret, err := r.coreClient.CoreV1().Secrets(pair.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Getting secret '%s': %s", name, err)
+	}
+	return secret, nil
+}
+
+// isReissueNeeded returns true if existing Secret was generated from different
+// inputs. Secrets that are not controlled by TLSPair are left as is.
+func (r *TLSPairReconciler) isReissueNeeded(pair *sgv1alpha1.TLSPair,
+	existingSecret *corev1.Secret, inputs GenerateInputs) bool {
+
+	return metav1.IsControlledBy(existingSecret, pair) && inputs.IsChanged(existingSecret.Annotations)
+}
+
+func (r *TLSPairReconciler) saveSecret(ctx context.Context, existingSecret *corev1.Secret,
+	secret *reconciler.Secret, defaultTemplate sgv1alpha1.SecretTemplate,
+	customTemplate *sgv1alpha1.SecretTemplate, inputs GenerateInputs) (*corev1.Secret, error) {
+
+	err := secret.ApplyTemplates(defaultTemplate, customTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	if newSecret.Annotations == nil {
+		newSecret.Annotations = map[string]string{}
+	}
+	err = inputs.Add(newSecret.Annotations)
+	if err != nil {
+		return nil, err
+	}
+
+	savedSecret, err := applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return nil, fmt.Errorf("Saving secret '%s': %s", newSecret.Name, err)
+	}
+
+	return savedSecret, nil
+}
+
+// pruneClientSecrets deletes Secrets of clients that were removed from spec.
+func (r *TLSPairReconciler) pruneClientSecrets(ctx context.Context, pair *sgv1alpha1.TLSPair) error {
+	desiredSecrets := map[string]struct{}{}
+	for _, client := range pair.Spec.Clients {
+		desiredSecrets[pair.ClientSecretName(client)] = struct{}{}
+	}
+
+	secretsClient := r.coreClient.CoreV1().Secrets(pair.Namespace)
+
+	secretList, err := secretsClient.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Listing secrets: %s", err)
+	}
+
+	for _, secret := range secretList.Items {
+		if !strings.HasPrefix(secret.Name, pair.Name+sgv1alpha1.TLSPairClientSecretInfix) {
+			continue
+		}
+		if _, found := desiredSecrets[secret.Name]; found || !metav1.IsControlledBy(&secret, pair) {
+			continue
+		}
+		err := secretsClient.Delete(ctx, secret.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("Deleting secret '%s': %s", secret.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *TLSPairReconciler) reconcileExports(ctx context.Context, pair *sgv1alpha1.TLSPair) error {
+	desiredExports := map[string]*sg2v1alpha1.SecretExport{}
+
+	for _, client := range pair.Spec.Clients {
+		if client.Export == nil {
+			continue
+		}
+
+		export := &sg2v1alpha1.SecretExport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pair.ClientSecretName(client),
+				Namespace: pair.Namespace,
+			},
+			Spec: sg2v1alpha1.SecretExportSpec{
+				ToNamespace:  client.Export.ToNamespace,
+				ToNamespaces: client.Export.ToNamespaces,
+			},
+		}
+
+		err := controllerutil.SetControllerReference(pair, export, scheme.Scheme)
+		if err != nil {
+			return fmt.Errorf("Setting owner of secret export '%s': %s", export.Name, err)
+		}
+
+		desiredExports[export.Name] = export
+	}
+
+	exportsClient := r.sg2Client.SecretgenV1alpha1().SecretExports(pair.Namespace)
+
+	for _, desiredExport := range desiredExports {
+		existingExport, err := exportsClient.Get(ctx, desiredExport.Name, metav1.GetOptions{})
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return fmt.Errorf("Getting secret export '%s': %s", desiredExport.Name, err)
+			}
+			_, err = exportsClient.Create(ctx, desiredExport, metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("Creating secret export '%s': %s", desiredExport.Name, err)
+			}
+			continue
+		}
+
+		if !metav1.IsControlledBy(existingExport, pair) {
+			return fmt.Errorf("Expected secret export '%s' to be controlled by TLSPair '%s'", existingExport.Name, pair.Name)
+		}
+
+		if reflect.DeepEqual(existingExport.Spec, desiredExport.Spec) {
+			continue
+		}
+
+		existingExport.Spec = desiredExport.Spec
+
+		_, err = exportsClient.Update(ctx, existingExport, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("Updating secret export '%s': %s", existingExport.Name, err)
+		}
+	}
+
+	// Remove exports for clients that are no longer exported
+	exportList, err := exportsClient.List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Listing secret exports: %s", err)
+	}
+
+	for _, export := range exportList.Items {
+		if _, found := desiredExports[export.Name]; found || !metav1.IsControlledBy(&export, pair) {
+			continue
+		}
+		err := exportsClient.Delete(ctx, export.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("Deleting secret export '%s': %s", export.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *TLSPairReconciler) updateStatus(ctx context.Context, pair *sgv1alpha1.TLSPair) error {
+	existingPair, err := r.sgClient.SecretgenV1alpha1().TLSPairs(pair.Namespace).Get(ctx, pair.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching tlspair: %s", err)
+	}
+
+	existingPair.Status = pair.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().TLSPairs(existingPair.Namespace).UpdateStatus(ctx, existingPair, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating tlspair status: %s", err)
+	}
+
+	return nil
+}
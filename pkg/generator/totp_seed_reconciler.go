@@ -0,0 +1,186 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-logr/logr"
+	qrcode "github.com/skip2/go-qrcode"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// totpQRCodeSize is width (and height) of QR code image in pixels
+const totpQRCodeSize = 256
+
+type TOTPSeedReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &TOTPSeedReconciler{}
+
+func NewTOTPSeedReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *TOTPSeedReconciler {
+	return &TOTPSeedReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *TOTPSeedReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.TOTPSeed{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *TOTPSeedReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	seed, err := r.sgClient.SecretgenV1alpha1().TOTPSeeds(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if seed.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		seed.Status.GenericStatus,
+		func(st sgv1alpha1.GenericStatus) { seed.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(seed.ObjectMeta)
+	defer r.updateStatus(ctx, seed)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, seed))
+}
+
+func (r *TOTPSeedReconciler) reconcile(ctx context.Context, seed *sgv1alpha1.TOTPSeed) (reconcile.Result, error) {
+	err := seed.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	rotation := newRotation(seed.ObjectMeta, seed.Status.LastRotation)
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(seed.Namespace).Get(ctx, seed.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.createSecret(ctx, seed, nil, rotation)
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() && metav1.IsControlledBy(existingSecret, seed) {
+		return r.createSecret(ctx, seed, existingSecret, rotation)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// createSecret generates new values and creates Secret
+// or replaces values of existing Secret when rotation is requested.
+func (r *TOTPSeedReconciler) createSecret(ctx context.Context, seed *sgv1alpha1.TOTPSeed,
+	existingSecret *corev1.Secret, rotation rotation) (reconcile.Result, error) {
+
+	seedBytes := make([]byte, seed.SeedBytes())
+
+	_, err := rand.Read(seedBytes)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Generating seed: %s", err)
+	}
+
+	encodedSeed := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(seedBytes)
+	uri := newTOTPURI(seed, encodedSeed)
+
+	qrCodePNG, err := qrcode.Encode(uri, qrcode.Medium, totpQRCodeSize)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Generating QR code: %s", err)
+	}
+
+	values := map[string][]byte{
+		sgv1alpha1.TOTPSeedSecretSeedKey:      []byte(encodedSeed),
+		sgv1alpha1.TOTPSeedSecretURIKey:       []byte(uri),
+		sgv1alpha1.TOTPSeedSecretQRCodePNGKey: qrCodePNG,
+	}
+
+	secret := reconciler.NewSecret(seed, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.TOTPSeedSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.TOTPSeedSecretDefaultSeedKey:      expansion.Variable(sgv1alpha1.TOTPSeedSecretSeedKey),
+			sgv1alpha1.TOTPSeedSecretDefaultURIKey:       expansion.Variable(sgv1alpha1.TOTPSeedSecretURIKey),
+			sgv1alpha1.TOTPSeedSecretDefaultQRCodePNGKey: expansion.Variable(sgv1alpha1.TOTPSeedSecretQRCodePNGKey),
+		},
+	}
+
+	err = secret.ApplyTemplates(defaultTemplate, seed.Spec.SecretTemplate)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() {
+		seed.Status.LastRotation = rotation.Status()
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *TOTPSeedReconciler) updateStatus(ctx context.Context, seed *sgv1alpha1.TOTPSeed) error {
+	existingSeed, err := r.sgClient.SecretgenV1alpha1().TOTPSeeds(seed.Namespace).Get(ctx, seed.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching totpseed: %s", err)
+	}
+
+	existingSeed.Status = seed.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().TOTPSeeds(existingSeed.Namespace).UpdateStatus(ctx, existingSeed, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating totpseed status: %s", err)
+	}
+
+	return nil
+}
+
+// newTOTPURI returns otpauth URI as described by Key Uri Format
+// (https://github.com/google/google-authenticator/wiki/Key-Uri-Format).
+func newTOTPURI(seed *sgv1alpha1.TOTPSeed, encodedSeed string) string {
+	escape := func(val string) string {
+		// Authenticator apps do not consistently decode + as space
+		return strings.ReplaceAll(url.QueryEscape(val), "+", "%20")
+	}
+
+	return fmt.Sprintf("otpauth://totp/%s:%s?secret=%s&issuer=%s&algorithm=%s&digits=%d&period=%d",
+		escape(seed.Spec.Issuer), escape(seed.Spec.Account), encodedSeed, escape(seed.Spec.Issuer),
+		seed.SeedAlgorithm(), seed.SeedDigits(), seed.SeedPeriod())
+}
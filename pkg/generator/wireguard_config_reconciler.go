@@ -0,0 +1,278 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// WireGuardConfigReconciler renders WireGuard configuration from WireGuardKey Secrets.
+type WireGuardConfigReconciler struct {
+	sgClient      sgclient.Interface
+	coreClient    kubernetes.Interface
+	secretTracker Tracker
+	log           logr.Logger
+}
+
+var _ reconcile.Reconciler = &WireGuardConfigReconciler{}
+
+// NewWireGuardConfigReconciler constructs WireGuardConfigReconciler.
+func NewWireGuardConfigReconciler(sgClient sgclient.Interface, coreClient kubernetes.Interface,
+	secretTracker Tracker, log logr.Logger) *WireGuardConfigReconciler {
+	return &WireGuardConfigReconciler{sgClient, coreClient, secretTracker, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *WireGuardConfigReconciler) AttachWatches(controller controller.Controller) error {
+	// Watch key Secrets so that configuration is re-rendered when keys change
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(
+		func(a client.Object) []reconcile.Request {
+			var requests []reconcile.Request
+			secretKey := types.NamespacedName{Namespace: a.GetNamespace(), Name: a.GetName()}
+			for _, tracking := range r.secretTracker.GetTracking(secretKey) {
+				requests = append(requests, reconcile.Request{NamespacedName: tracking})
+			}
+			return requests
+		},
+	))
+	if err != nil {
+		return err
+	}
+
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.WireGuardConfig{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *WireGuardConfigReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	wgConfig, err := r.sgClient.SecretgenV1alpha1().WireGuardConfigs(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			r.secretTracker.UntrackAll(request.NamespacedName)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if wgConfig.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		S:          wgConfig.Status.GenericStatus,
+		UpdateFunc: func(st sgv1alpha1.GenericStatus) { wgConfig.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(wgConfig.ObjectMeta)
+	defer r.updateStatus(ctx, wgConfig)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, wgConfig))
+}
+
+func (r *WireGuardConfigReconciler) reconcile(ctx context.Context, wgConfig *sgv1alpha1.WireGuardConfig) (reconcile.Result, error) {
+	err := wgConfig.Validate()
+	if err != nil {
+		return reconcile.Result{}, reconciler.TerminalReconcileErr{Err: err}
+	}
+
+	configKey := types.NamespacedName{Namespace: wgConfig.Namespace, Name: wgConfig.Name}
+
+	r.secretTracker.UntrackAll(configKey)
+	r.secretTracker.Track(configKey, types.NamespacedName{
+		Namespace: wgConfig.Namespace, Name: wgConfig.Spec.Interface.PrivateKeyRef.Name})
+
+	for _, peer := range wgConfig.Spec.Peers {
+		for _, ref := range []*sgv1alpha1.WireGuardConfigKeyRef{peer.PublicKeyRef, peer.PresharedKeyRef} {
+			if ref != nil {
+				r.secretTracker.Track(configKey, types.NamespacedName{Namespace: wgConfig.Namespace, Name: ref.Name})
+			}
+		}
+	}
+
+	config, publicKey, err := r.render(ctx, wgConfig)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	err = r.saveSecret(ctx, wgConfig, config)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	wgConfig.Status.PublicKey = publicKey
+	wgConfig.Status.Peers = len(wgConfig.Spec.Peers)
+
+	return reconcile.Result{}, nil
+}
+
+// render returns configuration in wg-quick(8) format and public key of interface.
+func (r *WireGuardConfigReconciler) render(ctx context.Context, wgConfig *sgv1alpha1.WireGuardConfig) (string, string, error) {
+	iface := wgConfig.Spec.Interface
+
+	privateKey, err := r.getKey(ctx, wgConfig.Namespace, iface.PrivateKeyRef, sgv1alpha1.WireGuardKeySecretDefaultPrivateKeyKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	privateKeyBytes, _ := base64.StdEncoding.DecodeString(privateKey)
+
+	ecdhKey, err := ecdh.X25519().NewPrivateKey(privateKeyBytes)
+	if err != nil {
+		return "", "", fmt.Errorf("Reading private key: %s", err)
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("[Interface]\n")
+	fmt.Fprintf(&buf, "PrivateKey = %s\n", privateKey)
+	if len(iface.Addresses) > 0 {
+		fmt.Fprintf(&buf, "Address = %s\n", strings.Join(iface.Addresses, ", "))
+	}
+	if iface.ListenPort > 0 {
+		fmt.Fprintf(&buf, "ListenPort = %d\n", iface.ListenPort)
+	}
+	if len(iface.DNS) > 0 {
+		fmt.Fprintf(&buf, "DNS = %s\n", strings.Join(iface.DNS, ", "))
+	}
+	if iface.MTU > 0 {
+		fmt.Fprintf(&buf, "MTU = %d\n", iface.MTU)
+	}
+
+	for _, peer := range wgConfig.Spec.Peers {
+		peerPublicKey := peer.PublicKey
+		if peer.PublicKeyRef != nil {
+			peerPublicKey, err = r.getKey(ctx, wgConfig.Namespace, *peer.PublicKeyRef, sgv1alpha1.WireGuardKeySecretDefaultPublicKeyKey)
+			if err != nil {
+				return "", "", err
+			}
+		}
+
+		buf.WriteString("\n")
+		if len(peer.Name) > 0 {
+			fmt.Fprintf(&buf, "# %s\n", peer.Name)
+		}
+		buf.WriteString("[Peer]\n")
+		fmt.Fprintf(&buf, "PublicKey = %s\n", peerPublicKey)
+
+		if peer.PresharedKeyRef != nil {
+			presharedKey, err := r.getKey(ctx, wgConfig.Namespace, *peer.PresharedKeyRef, sgv1alpha1.WireGuardKeySecretDefaultPresharedKeyKey)
+			if err != nil {
+				return "", "", err
+			}
+			fmt.Fprintf(&buf, "PresharedKey = %s\n", presharedKey)
+		}
+		if len(peer.AllowedIPs) > 0 {
+			fmt.Fprintf(&buf, "AllowedIPs = %s\n", strings.Join(peer.AllowedIPs, ", "))
+		}
+		if len(peer.Endpoint) > 0 {
+			fmt.Fprintf(&buf, "Endpoint = %s\n", peer.Endpoint)
+		}
+		if peer.PersistentKeepalive > 0 {
+			fmt.Fprintf(&buf, "PersistentKeepalive = %d\n", peer.PersistentKeepalive)
+		}
+	}
+
+	return buf.String(), base64.StdEncoding.EncodeToString(ecdhKey.PublicKey().Bytes()), nil
+}
+
+func (r *WireGuardConfigReconciler) getKey(ctx context.Context, namespace string,
+	ref sgv1alpha1.WireGuardConfigKeyRef, defaultKey string) (string, error) {
+
+	secret, err := r.coreClient.CoreV1().Secrets(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("Getting key secret: %s", err)
+	}
+
+	secretKey := ref.SecretKey(defaultKey)
+
+	key := strings.TrimSpace(string(secret.Data[secretKey]))
+	if len(key) == 0 {
+		return "", fmt.Errorf("Expected key secret '%s' to have '%s' key", secret.Name, secretKey)
+	}
+
+	err = sgv1alpha1.ValidateWireGuardKey(key)
+	if err != nil {
+		return "", fmt.Errorf("Reading key secret '%s' key '%s': %s", secret.Name, secretKey, err)
+	}
+
+	return key, nil
+}
+
+func (r *WireGuardConfigReconciler) saveSecret(ctx context.Context, wgConfig *sgv1alpha1.WireGuardConfig, config string) error {
+	values := map[string][]byte{
+		sgv1alpha1.WireGuardConfigSecretConfigKey: []byte(config),
+	}
+
+	secret := reconciler.NewSecret(wgConfig, values)
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.WireGuardConfigSecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.WireGuardConfigSecretDefaultConfigKey: expansion.Variable(sgv1alpha1.WireGuardConfigSecretConfigKey),
+		},
+	}
+
+	err := secret.ApplyTemplates(defaultTemplate, wgConfig.Spec.SecretTemplate)
+	if err != nil {
+		return err
+	}
+
+	newSecret := secret.AsSecret()
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(wgConfig.Namespace).Get(ctx, wgConfig.Name, metav1.GetOptions{})
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("Getting secret: %s", err)
+		}
+		_, err = applySecret(ctx, r.coreClient, nil, newSecret)
+		return err
+	}
+
+	if !metav1.IsControlledBy(existingSecret, wgConfig) {
+		return fmt.Errorf("Expected secret '%s' to be controlled by WireGuardConfig '%s'", existingSecret.Name, wgConfig.Name)
+	}
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	return err
+}
+
+func (r *WireGuardConfigReconciler) updateStatus(ctx context.Context, wgConfig *sgv1alpha1.WireGuardConfig) error {
+	existingConfig, err := r.sgClient.SecretgenV1alpha1().WireGuardConfigs(wgConfig.Namespace).Get(ctx, wgConfig.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching wireguardconfig: %s", err)
+	}
+
+	existingConfig.Status = wgConfig.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().WireGuardConfigs(existingConfig.Namespace).UpdateStatus(ctx, existingConfig, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating wireguardconfig status: %s", err)
+	}
+
+	return nil
+}
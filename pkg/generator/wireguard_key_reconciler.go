@@ -0,0 +1,199 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package generator
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sgclient "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/client/clientset/versioned"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/expansion"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+type WireGuardKeyReconciler struct {
+	sgClient   sgclient.Interface
+	coreClient kubernetes.Interface
+	log        logr.Logger
+}
+
+var _ reconcile.Reconciler = &WireGuardKeyReconciler{}
+
+func NewWireGuardKeyReconciler(sgClient sgclient.Interface,
+	coreClient kubernetes.Interface, log logr.Logger) *WireGuardKeyReconciler {
+	return &WireGuardKeyReconciler{sgClient, coreClient, log}
+}
+
+// AttachWatches adds starts watches this reconciler requires.
+func (r *WireGuardKeyReconciler) AttachWatches(controller controller.Controller) error {
+	return controller.Watch(&source.Kind{Type: &sgv1alpha1.WireGuardKey{}}, &handler.EnqueueRequestForObject{})
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *WireGuardKeyReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	wgKey, err := r.sgClient.SecretgenV1alpha1().WireGuardKeys(request.Namespace).Get(ctx, request.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("Not found")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if wgKey.DeletionTimestamp != nil {
+		// Nothing to do
+		return reconcile.Result{}, nil
+	}
+
+	status := &reconciler.Status{
+		wgKey.Status.GenericStatus,
+		func(st sgv1alpha1.GenericStatus) { wgKey.Status.GenericStatus = st },
+	}
+
+	status.SetReconciling(wgKey.ObjectMeta)
+	defer r.updateStatus(ctx, wgKey)
+
+	return status.WithReconcileCompleted(r.reconcile(ctx, wgKey))
+}
+
+func (r *WireGuardKeyReconciler) reconcile(ctx context.Context, wgKey *sgv1alpha1.WireGuardKey) (reconcile.Result, error) {
+	rotation := newRotation(wgKey.ObjectMeta, wgKey.Status.LastRotation)
+
+	existingSecret, err := r.coreClient.CoreV1().Secrets(wgKey.Namespace).Get(ctx, wgKey.Name, metav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return r.createSecret(ctx, wgKey, nil, rotation)
+		}
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() && metav1.IsControlledBy(existingSecret, wgKey) {
+		return r.createSecret(ctx, wgKey, existingSecret, rotation)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// createSecret generates new values and creates Secret
+// or replaces values of existing Secret when rotation is requested.
+func (r *WireGuardKeyReconciler) createSecret(ctx context.Context, wgKey *sgv1alpha1.WireGuardKey,
+	existingSecret *corev1.Secret, rotation rotation) (reconcile.Result, error) {
+
+	privateKey, publicKey, err := newWireGuardKeyPair()
+	if err != nil {
+		return reconcile.Result{Requeue: true}, fmt.Errorf("Generating wireguard key: %s", err)
+	}
+
+	values := map[string][]byte{
+		sgv1alpha1.WireGuardKeySecretPrivateKeyKey: []byte(privateKey),
+		sgv1alpha1.WireGuardKeySecretPublicKeyKey:  []byte(publicKey),
+	}
+
+	defaultTemplate := sgv1alpha1.SecretTemplate{
+		Type: sgv1alpha1.WireGuardKeySecretDefaultType,
+		StringData: map[string]string{
+			sgv1alpha1.WireGuardKeySecretDefaultPrivateKeyKey: expansion.Variable(sgv1alpha1.WireGuardKeySecretPrivateKeyKey),
+			sgv1alpha1.WireGuardKeySecretDefaultPublicKeyKey:  expansion.Variable(sgv1alpha1.WireGuardKeySecretPublicKeyKey),
+		},
+	}
+
+	if wgKey.Spec.PresharedKey {
+		presharedKey, err := newWireGuardPresharedKey()
+		if err != nil {
+			return reconcile.Result{Requeue: true}, fmt.Errorf("Generating wireguard preshared key: %s", err)
+		}
+
+		values[sgv1alpha1.WireGuardKeySecretPresharedKeyKey] = []byte(presharedKey)
+		defaultTemplate.StringData[sgv1alpha1.WireGuardKeySecretDefaultPresharedKeyKey] =
+			expansion.Variable(sgv1alpha1.WireGuardKeySecretPresharedKeyKey)
+	}
+
+	secret := reconciler.NewSecret(wgKey, values)
+
+	err = secret.ApplyTemplates(defaultTemplate, wgKey.Spec.SecretTemplate)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	newSecret := secret.AsSecret()
+
+	_, err = applySecret(ctx, r.coreClient, existingSecret, newSecret)
+	if err != nil {
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	if rotation.IsRequested() {
+		wgKey.Status.LastRotation = rotation.Status()
+	}
+
+	wgKey.Status.PublicKey = publicKey
+
+	return reconcile.Result{}, nil
+}
+
+func (r *WireGuardKeyReconciler) updateStatus(ctx context.Context, wgKey *sgv1alpha1.WireGuardKey) error {
+	existingWGKey, err := r.sgClient.SecretgenV1alpha1().WireGuardKeys(wgKey.Namespace).Get(ctx, wgKey.Name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Fetching wireguardkey: %s", err)
+	}
+
+	existingWGKey.Status = wgKey.Status
+
+	_, err = r.sgClient.SecretgenV1alpha1().WireGuardKeys(existingWGKey.Namespace).UpdateStatus(ctx, existingWGKey, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("Updating wireguardkey status: %s", err)
+	}
+
+	return nil
+}
+
+// newWireGuardKeyPair returns base64 encoded Curve25519 private and public keys
+// (same as produced by `wg genkey` and `wg pubkey`).
+func newWireGuardKeyPair() (string, string, error) {
+	bs := make([]byte, 32)
+
+	_, err := rand.Read(bs)
+	if err != nil {
+		return "", "", err
+	}
+
+	// Clamp private key as done by wg(8)
+	bs[0] &= 248
+	bs[31] = (bs[31] & 127) | 64
+
+	privateKey, err := ecdh.X25519().NewPrivateKey(bs)
+	if err != nil {
+		return "", "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(privateKey.Bytes()),
+		base64.StdEncoding.EncodeToString(privateKey.PublicKey().Bytes()), nil
+}
+
+// newWireGuardPresharedKey returns base64 encoded random 32 bytes (same as `wg genpsk`).
+func newWireGuardPresharedKey() (string, error) {
+	bs := make([]byte, 32)
+
+	_, err := rand.Read(bs)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(bs), nil
+}
@@ -18,9 +18,15 @@ type Secret struct {
 }
 
 func NewSecret(owner metav1.Object, values map[string][]byte) *Secret {
+	return NewSecretWithName(owner, owner.GetName(), values)
+}
+
+// NewSecretWithName is similar to NewSecret but allows to name Secret
+// differently than its owner (useful when owner produces multiple Secrets).
+func NewSecretWithName(owner metav1.Object, name string, values map[string][]byte) *Secret {
 	secret := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:        owner.GetName(),
+			Name:        name,
 			Namespace:   owner.GetNamespace(),
 			Labels:      owner.GetLabels(),
 			Annotations: owner.GetAnnotations(),
@@ -75,6 +81,11 @@ func (p *Secret) ApplyTemplate(template sgv1alpha1.SecretTemplate) error {
 		p.secret.Type = template.Type
 	}
 
+	if template.Immutable {
+		immutable := true
+		p.secret.Immutable = &immutable
+	}
+
 	if len(template.StringData) > 0 {
 		expandFunc := expansion.MappingFuncFor(p.valuesAsStringMap())
 		newData := map[string][]byte{}
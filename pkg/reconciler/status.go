@@ -53,6 +53,14 @@ func (s *Status) SetReconciling(meta metav1.ObjectMeta) {
 	s.UpdateFunc(s.S)
 }
 
+// SetReconcilingProgress keeps Reconciling condition while describing
+// progress of reconciliation that spans multiple reconciles.
+func (s *Status) SetReconcilingProgress(desc string) {
+	s.S.FriendlyDescription = desc
+
+	s.UpdateFunc(s.S)
+}
+
 func (s *Status) SetReconcileCompleted(err error) {
 	s.removeAllConditions()
 
@@ -0,0 +1,7 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+// rollout package triggers rollouts of workloads (Deployments,
+// StatefulSets and DaemonSets) when Secrets managed by secretgen-controller
+// that they opted into change.
+package rollout
@@ -0,0 +1,277 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	sg2v1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen2/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// SecretsAnnKey is set on workloads to a comma separated list
+	// of Secret names (in the same namespace) that should trigger a rollout
+	SecretsAnnKey = "secretgen.carvel.dev/rollout-on-secret-change"
+	// ChecksumAnnKey is set on workload (and its pod template once
+	// referenced Secrets change) to a checksum of referenced Secrets
+	// so that changing them results in a rollout
+	ChecksumAnnKey = "secretgen.carvel.dev/secrets-checksum"
+)
+
+// WorkloadReconciler watches Secrets and patches checksum of their data
+// into pod templates of Deployments, StatefulSets and DaemonSets
+// that reference them via annotation once their data changes. Only Secrets controlled by
+// secretgen-controller resources (e.g. generated or imported) are considered.
+type WorkloadReconciler struct {
+	client client.Client
+	log    logr.Logger
+}
+
+var _ reconcile.Reconciler = &WorkloadReconciler{}
+
+// NewWorkloadReconciler constructs WorkloadReconciler.
+func NewWorkloadReconciler(client client.Client, log logr.Logger) *WorkloadReconciler {
+	return &WorkloadReconciler{client, log}
+}
+
+func (r *WorkloadReconciler) AttachWatches(controller controller.Controller) error {
+	// Reconcile requests are Secret names; workloads referencing them are found on reconcile
+	err := controller.Watch(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestForObject{})
+	if err != nil {
+		return fmt.Errorf("Watching secrets: %s", err)
+	}
+
+	for _, workload := range []client.Object{&appsv1.Deployment{}, &appsv1.StatefulSet{}, &appsv1.DaemonSet{}} {
+		// Enqueue referenced Secrets so that newly annotated workloads get checksum
+		err := controller.Watch(&source.Kind{Type: workload}, handler.EnqueueRequestsFromMapFunc(r.mapWorkloadToSecrets))
+		if err != nil {
+			return fmt.Errorf("Watching %T: %s", workload, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *WorkloadReconciler) mapWorkloadToSecrets(workload client.Object) []reconcile.Request {
+	var result []reconcile.Request
+	for _, name := range secretNames(workload) {
+		result = append(result, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      name,
+				Namespace: workload.GetNamespace(),
+			},
+		})
+	}
+	return result
+}
+
+// Reconcile is the entrypoint for incoming requests from k8s
+func (r *WorkloadReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := r.log.WithValues("request", request)
+
+	workloads, err := r.listWorkloads(ctx, request.Namespace)
+	if err != nil {
+		// Requeue to try to list a bit later
+		return reconcile.Result{Requeue: true}, err
+	}
+
+	for _, workload := range workloads {
+		names := secretNames(workload)
+		if workload.GetDeletionTimestamp() != nil || !containsString(names, request.Name) {
+			continue
+		}
+
+		checksum, err := r.checksum(ctx, request.Namespace, names)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+
+		err = r.patchWorkload(ctx, workload, checksum, log)
+		if err != nil {
+			return reconcile.Result{Requeue: true}, err
+		}
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func (r *WorkloadReconciler) listWorkloads(ctx context.Context, namespace string) ([]client.Object, error) {
+	var result []client.Object
+
+	var deployments appsv1.DeploymentList
+	err := r.client.List(ctx, &deployments, client.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("Listing deployments: %s", err)
+	}
+	for i := range deployments.Items {
+		result = append(result, &deployments.Items[i])
+	}
+
+	var statefulSets appsv1.StatefulSetList
+	err = r.client.List(ctx, &statefulSets, client.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("Listing statefulsets: %s", err)
+	}
+	for i := range statefulSets.Items {
+		result = append(result, &statefulSets.Items[i])
+	}
+
+	var daemonSets appsv1.DaemonSetList
+	err = r.client.List(ctx, &daemonSets, client.InNamespace(namespace))
+	if err != nil {
+		return nil, fmt.Errorf("Listing daemonsets: %s", err)
+	}
+	for i := range daemonSets.Items {
+		result = append(result, &daemonSets.Items[i])
+	}
+
+	return result, nil
+}
+
+// checksum returns digest of data of referenced Secrets. Secrets that
+// do not exist or are not managed by secretgen-controller contribute no data.
+func (r *WorkloadReconciler) checksum(ctx context.Context, namespace string, names []string) (string, error) {
+	secretsData := map[string]map[string][]byte{}
+
+	for _, name := range names {
+		var secret corev1.Secret
+
+		err := r.client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &secret)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				secretsData[name] = nil
+				continue
+			}
+			return "", fmt.Errorf("Getting secret: %s", err)
+		}
+
+		if isManagedSecret(secret) {
+			secretsData[name] = secret.Data
+		} else {
+			secretsData[name] = nil
+		}
+	}
+
+	// JSON encoding sorts map keys hence resulting checksum is stable
+	encodedData, err := json.Marshal(secretsData)
+	if err != nil {
+		return "", fmt.Errorf("Encoding secrets data: %s", err)
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(encodedData)), nil
+}
+
+// patchWorkload records checksum on workload. Workloads seen for the first
+// time only get checksum recorded in their metadata (baseline) so that
+// opting in does not result in a rollout. Pod template is patched (hence
+// rollout is triggered) only when checksum differs from recorded one.
+func (r *WorkloadReconciler) patchWorkload(ctx context.Context, workload client.Object, checksum string, log logr.Logger) error {
+	original := workload.DeepCopyObject().(client.Object)
+	template := podTemplate(workload)
+
+	recordedChecksum := template.Annotations[ChecksumAnnKey]
+	if len(recordedChecksum) == 0 {
+		recordedChecksum = workload.GetAnnotations()[ChecksumAnnKey]
+	}
+
+	if recordedChecksum == checksum {
+		return nil
+	}
+
+	anns := workload.GetAnnotations()
+	if anns == nil {
+		anns = map[string]string{}
+	}
+	anns[ChecksumAnnKey] = checksum
+	workload.SetAnnotations(anns)
+
+	if len(recordedChecksum) > 0 {
+		if template.Annotations == nil {
+			template.Annotations = map[string]string{}
+		}
+		template.Annotations[ChecksumAnnKey] = checksum
+
+		log.Info("Triggering rollout", "kind", fmt.Sprintf("%T", workload), "name", workload.GetName())
+	}
+
+	err := r.client.Patch(ctx, workload, client.MergeFrom(original))
+	if err != nil {
+		return fmt.Errorf("Patching '%s' checksum: %s", workload.GetName(), err)
+	}
+
+	return nil
+}
+
+func podTemplate(workload client.Object) *corev1.PodTemplateSpec {
+	switch typedWorkload := workload.(type) {
+	case *appsv1.Deployment:
+		return &typedWorkload.Spec.Template
+	case *appsv1.StatefulSet:
+		return &typedWorkload.Spec.Template
+	case *appsv1.DaemonSet:
+		return &typedWorkload.Spec.Template
+	default:
+		panic(fmt.Sprintf("Internal inconsistency: unknown workload type %T", workload))
+	}
+}
+
+// secretNames returns sorted unique Secret names referenced by workload annotation
+func secretNames(workload client.Object) []string {
+	seen := map[string]struct{}{}
+	var result []string
+
+	for _, name := range strings.Split(workload.GetAnnotations()[SecretsAnnKey], ",") {
+		name = strings.TrimSpace(name)
+		if len(name) == 0 {
+			continue
+		}
+		if _, found := seen[name]; !found {
+			seen[name] = struct{}{}
+			result = append(result, name)
+		}
+	}
+
+	sort.Strings(result)
+	return result
+}
+
+// isManagedSecret returns true if Secret is controlled by one of secretgen-controller resources
+func isManagedSecret(secret corev1.Secret) bool {
+	owner := metav1.GetControllerOf(&secret)
+	if owner == nil {
+		return false
+	}
+	gv, err := schema.ParseGroupVersion(owner.APIVersion)
+	if err != nil {
+		return false
+	}
+	return gv.Group == sgv1alpha1.SchemeGroupVersion.Group || gv.Group == sg2v1alpha1.SchemeGroupVersion.Group
+}
+
+func containsString(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
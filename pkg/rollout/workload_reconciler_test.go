@@ -0,0 +1,136 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package rollout_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	"github.com/vmware-tanzu/carvel-secretgen-controller/pkg/rollout"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func Test_WorkloadReconciler(t *testing.T) {
+	t.Run("records baseline checksum and patches pod templates once managed secrets change", func(t *testing.T) {
+		secret := managedSecret("db-password", "secret1")
+		deployment := deploymentReferencing("app", "db-password")
+		unrelatedDeployment := deploymentReferencing("unrelated", "other")
+
+		reconciler, k8sClient := buildReconciler(&secret, &deployment, &unrelatedDeployment)
+
+		reconcileSecret(t, reconciler, secret)
+
+		// Opting in does not trigger a rollout
+		baseline := workloadChecksum(t, k8sClient, deployment.Name)
+		assert.NotEmpty(t, baseline)
+		assert.Empty(t, podTemplateChecksum(t, k8sClient, deployment.Name))
+		assert.Empty(t, workloadChecksum(t, k8sClient, unrelatedDeployment.Name))
+
+		// Checksum stays the same while secret does not change
+		reconcileSecret(t, reconciler, secret)
+		assert.Equal(t, baseline, workloadChecksum(t, k8sClient, deployment.Name))
+		assert.Empty(t, podTemplateChecksum(t, k8sClient, deployment.Name))
+
+		secret.Data["password"] = []byte("secret2")
+		require.NoError(t, k8sClient.Update(context.Background(), &secret))
+
+		reconcileSecret(t, reconciler, secret)
+		checksum := podTemplateChecksum(t, k8sClient, deployment.Name)
+		assert.NotEmpty(t, checksum)
+		assert.NotEqual(t, baseline, checksum)
+		assert.Equal(t, checksum, workloadChecksum(t, k8sClient, deployment.Name))
+
+		secret.Data["password"] = []byte("secret3")
+		require.NoError(t, k8sClient.Update(context.Background(), &secret))
+
+		reconcileSecret(t, reconciler, secret)
+		assert.NotEqual(t, checksum, podTemplateChecksum(t, k8sClient, deployment.Name))
+	})
+
+	t.Run("ignores changes to secrets not managed by secretgen-controller", func(t *testing.T) {
+		secret := managedSecret("db-password", "secret1")
+		secret.OwnerReferences = nil
+		deployment := deploymentReferencing("app", "db-password")
+
+		reconciler, k8sClient := buildReconciler(&secret, &deployment)
+
+		reconcileSecret(t, reconciler, secret)
+		checksum := workloadChecksum(t, k8sClient, deployment.Name)
+
+		secret.Data["password"] = []byte("secret2")
+		require.NoError(t, k8sClient.Update(context.Background(), &secret))
+
+		reconcileSecret(t, reconciler, secret)
+		assert.Equal(t, checksum, workloadChecksum(t, k8sClient, deployment.Name))
+		assert.Empty(t, podTemplateChecksum(t, k8sClient, deployment.Name))
+	})
+}
+
+func buildReconciler(objects ...client.Object) (*rollout.WorkloadReconciler, client.Client) {
+	k8sClient := fakeClient.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objects...).Build()
+	return rollout.NewWorkloadReconciler(k8sClient, zap.New(zap.UseDevMode(true))), k8sClient
+}
+
+func reconcileSecret(t *testing.T, reconciler *rollout.WorkloadReconciler, secret corev1.Secret) {
+	_, err := reconciler.Reconcile(context.Background(), reconcile.Request{
+		NamespacedName: types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name},
+	})
+	require.NoError(t, err)
+}
+
+func podTemplateChecksum(t *testing.T, k8sClient client.Client, name string) string {
+	deployment := getDeployment(t, k8sClient, name)
+	return deployment.Spec.Template.Annotations[rollout.ChecksumAnnKey]
+}
+
+func workloadChecksum(t *testing.T, k8sClient client.Client, name string) string {
+	deployment := getDeployment(t, k8sClient, name)
+	return deployment.Annotations[rollout.ChecksumAnnKey]
+}
+
+func getDeployment(t *testing.T, k8sClient client.Client, name string) appsv1.Deployment {
+	var deployment appsv1.Deployment
+	err := k8sClient.Get(context.Background(), types.NamespacedName{Namespace: "test", Name: name}, &deployment)
+	require.NoError(t, err)
+	return deployment
+}
+
+func managedSecret(name, password string) corev1.Secret {
+	isController := true
+	return corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "test",
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: sgv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "Password",
+				Name:       name,
+				UID:        "password-uid",
+				Controller: &isController,
+			}},
+		},
+		Data: map[string][]byte{"password": []byte(password)},
+	}
+}
+
+func deploymentReferencing(name, secretNames string) appsv1.Deployment {
+	return appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "test",
+			Annotations: map[string]string{rollout.SecretsAnnKey: secretNames},
+		},
+	}
+}
@@ -73,7 +73,7 @@ type Manager struct {
 // * If refresh fails and the old token is still valid, log an error and return the old token.
 // * If refresh fails and the old token is no longer valid, return an error
 func (m *Manager) GetServiceAccountToken(ctx context.Context, namespace, name string, tr *authenticationv1.TokenRequest) (*authenticationv1.TokenRequest, error) {
-	key := fmt.Sprintf("%q/%q", name, namespace)
+	key := keyFunc(name, namespace, tr)
 
 	ctr, ok := m.get(key)
 
@@ -98,6 +98,23 @@ func (m *Manager) GetServiceAccountToken(ctx context.Context, namespace, name st
 	return tr, nil
 }
 
+// keyFunc keys the token cache by all request parameters so that requests
+// for the same ServiceAccount with different expiration or audiences
+// do not share tokens.
+func keyFunc(name, namespace string, tr *authenticationv1.TokenRequest) string {
+	var exp int64
+	if tr.Spec.ExpirationSeconds != nil {
+		exp = *tr.Spec.ExpirationSeconds
+	}
+
+	var ref authenticationv1.BoundObjectReference
+	if tr.Spec.BoundObjectRef != nil {
+		ref = *tr.Spec.BoundObjectRef
+	}
+
+	return fmt.Sprintf("%q/%q/%#v/%#v/%#v", name, namespace, tr.Spec.Audiences, exp, ref)
+}
+
 func (m *Manager) cleanup() {
 	m.cacheMutex.Lock()
 	defer m.cacheMutex.Unlock()
@@ -237,6 +237,23 @@ func TestCleanup(t *testing.T) {
 	}
 }
 
+func TestKeyFunc(t *testing.T) {
+	otherExpiration := int64(3000)
+
+	otherExpirationRequest := getTokenRequest()
+	otherExpirationRequest.Spec.ExpirationSeconds = &otherExpiration
+
+	otherAudiencesRequest := getTokenRequest()
+	otherAudiencesRequest.Spec.Audiences = []string{"foo3"}
+
+	key := keyFunc("a", "b", getTokenRequest())
+
+	assert.Equal(t, key, keyFunc("a", "b", getTokenRequest()), "expected same requests to share key")
+	assert.NotEqual(t, key, keyFunc("a", "c", getTokenRequest()), "expected different namespaces to not share key")
+	assert.NotEqual(t, key, keyFunc("a", "b", otherExpirationRequest), "expected different expirations to not share key")
+	assert.NotEqual(t, key, keyFunc("a", "b", otherAudiencesRequest), "expected different audiences to not share key")
+}
+
 type fakeTokenGetter struct {
 	count   int
 	request *authenticationv1.TokenRequest
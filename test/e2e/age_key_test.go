@@ -0,0 +1,89 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"filippo.io/age"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestAgeKey(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: AgeKey
+metadata:
+  name: age-key
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: AgeKey
+metadata:
+  name: age-key-template
+spec:
+  secretTemplate:
+    type: Opaque
+    stringData:
+      identity: $(identity)
+`
+
+	name := "test-age-key"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check identity and recipient", func() {
+		secret := waitForSecretObj(t, kubectl, "age-key")
+		require.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+
+		require.True(t, strings.HasPrefix(string(secret.Data["key.txt"]), "AGE-SECRET-KEY-1"))
+		require.True(t, strings.HasPrefix(string(secret.Data["recipient.txt"]), "age1"))
+
+		identity, err := age.ParseX25519Identity(strings.TrimSpace(string(secret.Data["key.txt"])))
+		require.NoError(t, err)
+		require.Equal(t, identity.Recipient().String(), strings.TrimSpace(string(secret.Data["recipient.txt"])))
+
+		out := kubectl.Run([]string{"get", "agekey", "age-key", "-o", "jsonpath={.status.recipient}"})
+		require.Equal(t, identity.Recipient().String(), out)
+
+		var encrypted bytes.Buffer
+		w, err := age.Encrypt(&encrypted, identity.Recipient())
+		require.NoError(t, err)
+		_, err = io.WriteString(w, "secret")
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := age.Decrypt(&encrypted, identity)
+		require.NoError(t, err)
+		decrypted, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, "secret", string(decrypted))
+	})
+
+	logger.Section("Check secret template", func() {
+		secret := waitForSecretObj(t, kubectl, "age-key-template")
+
+		require.Len(t, secret.Data, 1)
+		require.True(t, strings.HasPrefix(string(secret.Data["identity"]), "AGE-SECRET-KEY-1"))
+	})
+}
@@ -4,10 +4,14 @@
 package e2e
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -104,3 +108,103 @@ spec:
 		}
 	})
 }
+
+func TestCertificateValidityAndEncodings(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Certificate
+metadata:
+  name: ca-cert
+spec:
+  isCA: true
+  validity: 30d
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Certificate
+metadata:
+  name: app1-cert
+spec:
+  caRef:
+    name: ca-cert
+  validity: 1d12h
+  notBeforeSkew: 10m
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Certificate
+metadata:
+  name: app2-cert
+spec:
+  caRef:
+    name: ca-cert
+  secretTemplate:
+    type: Opaque
+    stringData:
+      haproxy.pem: $(combinedPem)
+      crt.der: $(certificateDer)
+      key.pk8.pem: $(privateKeyPkcs8)
+      pub.pem: $(publicKey)
+`
+
+	name := "test-certificate-validity-encodings"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check validity", func() {
+		out := waitForSecret(t, kubectl, "app1-cert")
+
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(out), &secret)
+		require.NoError(t, err)
+
+		block, _ := pem.Decode(secret.Data["crt.pem"])
+		require.NotNil(t, block)
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		require.NoError(t, err)
+
+		require.Equal(t, 36*time.Hour+10*time.Minute, cert.NotAfter.Sub(cert.NotBefore))
+	})
+	logger.Section("Check encodings", func() {
+		out := waitForSecret(t, kubectl, "app2-cert")
+
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(out), &secret)
+		require.NoError(t, err)
+
+		certBlock, rest := pem.Decode(secret.Data["haproxy.pem"])
+		require.NotNil(t, certBlock)
+		require.Equal(t, "CERTIFICATE", certBlock.Type)
+		require.Equal(t, certBlock.Bytes, secret.Data["crt.der"])
+
+		keyBlock, _ := pem.Decode(rest)
+		require.NotNil(t, keyBlock)
+		require.Equal(t, "RSA PRIVATE KEY", keyBlock.Type)
+
+		pk8Block, _ := pem.Decode(secret.Data["key.pk8.pem"])
+		require.NotNil(t, pk8Block)
+		require.Equal(t, "PRIVATE KEY", pk8Block.Type)
+
+		_, err = x509.ParsePKCS8PrivateKey(pk8Block.Bytes)
+		require.NoError(t, err)
+
+		pubBlock, _ := pem.Decode(secret.Data["pub.pem"])
+		require.NotNil(t, pubBlock)
+		require.Equal(t, "PUBLIC KEY", pubBlock.Type)
+	})
+}
@@ -0,0 +1,130 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDHParam(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: DHParam
+metadata:
+  name: dhparam-secret
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: DHParam
+metadata:
+  name: dhparam-configmap
+spec:
+  output: ConfigMap
+`
+
+	name := "test-dh-param"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		// Do not wait for reconciliation since generation takes a while
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name, "--wait=false"},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check Secret output", func() {
+		waitForDHParam(t, kubectl, "dhparam-secret")
+
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(waitForSecret(t, kubectl, "dhparam-secret")), &secret)
+		require.NoError(t, err)
+
+		require.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+		checkDHParamPEM(t, secret.Data["dhparam.pem"], 2048)
+	})
+
+	logger.Section("Check ConfigMap output", func() {
+		waitForDHParam(t, kubectl, "dhparam-configmap")
+
+		var configMap corev1.ConfigMap
+
+		err := yaml.Unmarshal([]byte(waitForConfigMap(t, kubectl, "dhparam-configmap")), &configMap)
+		require.NoError(t, err)
+
+		checkDHParamPEM(t, []byte(configMap.Data["dhparam.pem"]), 2048)
+	})
+
+	logger.Section("Delete Secret output when output kind changes", func() {
+		kubectl.Run([]string{"patch", "dhparam", "dhparam-secret", "--type=merge", "-p", `{"spec":{"output":"ConfigMap"}}`})
+
+		// Previous output is deleted once parameters are generated again
+		secretDeleted := false
+		for i := 0; i < 600 && !secretDeleted; i++ {
+			_, err := kubectl.RunWithOpts([]string{"get", "secret", "dhparam-secret"}, RunOpts{AllowError: true})
+			secretDeleted = err != nil
+			time.Sleep(time.Second)
+		}
+		require.True(t, secretDeleted, "Expected previous Secret output to be deleted")
+
+		var configMap corev1.ConfigMap
+
+		err := yaml.Unmarshal([]byte(waitForConfigMap(t, kubectl, "dhparam-secret")), &configMap)
+		require.NoError(t, err)
+
+		checkDHParamPEM(t, []byte(configMap.Data["dhparam.pem"]), 2048)
+	})
+}
+
+func waitForDHParam(t *testing.T, kubectl Kubectl, name string) {
+	var out string
+
+	for i := 0; i < 600; i++ {
+		out = kubectl.Run([]string{"get", "dhparam", name, "-o", "jsonpath={.status.bits}"})
+		if len(out) > 0 {
+			return
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected dhparam '%s' to be generated but was not", name)
+}
+
+func checkDHParamPEM(t *testing.T, data []byte, bits int) {
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+	require.Equal(t, "DH PARAMETERS", block.Type)
+
+	var params struct {
+		P *big.Int
+		G int
+	}
+
+	_, err := asn1.Unmarshal(block.Bytes, &params)
+	require.NoError(t, err)
+
+	require.Equal(t, bits, params.P.BitLen())
+	require.Equal(t, 2, params.G)
+	require.True(t, params.P.ProbablyPrime(20))
+	require.True(t, new(big.Int).Rsh(params.P, 1).ProbablyPrime(20))
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestDockerConfig(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: sg-docker-config-test1
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: registry-password
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: DockerConfig
+metadata:
+  name: registry-creds
+spec:
+  registry: registry.example.com
+  username: ci
+  passwordRef:
+    name: registry-password
+---
+apiVersion: secretgen.carvel.dev/v1alpha1
+kind: SecretExport
+metadata:
+  name: registry-creds
+spec:
+  toNamespace: sg-docker-config-test1
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: registry-creds
+  namespace: sg-docker-config-test1
+  annotations:
+    secretgen.carvel.dev/image-pull-secret: ""
+type: kubernetes.io/dockerconfigjson
+data:
+  .dockerconfigjson: "e30K"
+`
+
+	name := "test-docker-config"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	password := string(waitForSecretObj(t, kubectl, "registry-password").Data["password"])
+
+	logger.Section("Check generated secret", func() {
+		secret := waitForSecretObj(t, kubectl, "registry-creds")
+		require.Equal(t, corev1.SecretTypeDockerConfigJson, secret.Type)
+
+		auths := parseDockerConfigJSON(t, secret.Data[corev1.DockerConfigJsonKey])
+		require.Equal(t, "ci", auths["registry.example.com"]["username"])
+		require.Equal(t, password, auths["registry.example.com"]["password"])
+
+		auth, err := base64.StdEncoding.DecodeString(auths["registry.example.com"]["auth"])
+		require.NoError(t, err)
+		require.Equal(t, "ci:"+password, string(auth))
+	})
+
+	logger.Section("Check image pull secret placeholder", func() {
+		var auths map[string]map[string]string
+
+		for i := 0; i < 30; i++ {
+			secret := waitForSecretObjInNs(t, kubectl, "sg-docker-config-test1", "registry-creds")
+			auths = parseDockerConfigJSON(t, secret.Data[corev1.DockerConfigJsonKey])
+			if len(auths) > 0 {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.Equal(t, password, auths["registry.example.com"]["password"])
+	})
+
+	logger.Section("Update credentials when password changes", func() {
+		kubectl.Run([]string{"patch", "secret", "registry-password", "--type", "merge",
+			"-p", `{"stringData":{"password":"rotated-password"}}`})
+
+		var auths map[string]map[string]string
+
+		for i := 0; i < 30; i++ {
+			secret := waitForSecretObj(t, kubectl, "registry-creds")
+			auths = parseDockerConfigJSON(t, secret.Data[corev1.DockerConfigJsonKey])
+			if auths["registry.example.com"]["password"] != password {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.Equal(t, "rotated-password", auths["registry.example.com"]["password"])
+	})
+}
+
+func parseDockerConfigJSON(t *testing.T, data []byte) map[string]map[string]string {
+	var config struct {
+		Auths map[string]map[string]string `json:"auths"`
+	}
+
+	err := json.Unmarshal(data, &config)
+	require.NoError(t, err)
+
+	return config.Auths
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+)
+
+type encryptionKeyringJSON struct {
+	Primary string `json:"primary"`
+	Keys    []struct {
+		Name      string `json:"name"`
+		Algorithm string `json:"algorithm"`
+		Provider  string `json:"provider"`
+		Secret    string `json:"secret"`
+		Staged    bool   `json:"staged"`
+	} `json:"keys"`
+}
+
+func TestEncryptionKeyring(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	// Short interval is used to observe rotation within a test
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: EncryptionKeyring
+metadata:
+  name: keyring
+spec:
+  rotationInterval: 20s
+  maxKeys: 2
+`
+
+	name := "test-encryption-keyring"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	var initialKeyring encryptionKeyringJSON
+
+	logger.Section("Check initial keyring", func() {
+		secret := waitForSecretObj(t, kubectl, "keyring")
+
+		err := json.Unmarshal(secret.Data["keyring.json"], &initialKeyring)
+		require.NoError(t, err)
+		require.Len(t, initialKeyring.Keys, 2, "Expected primary and staged keys")
+		require.Equal(t, "key1", initialKeyring.Primary)
+		require.Equal(t, "key2", initialKeyring.Keys[0].Name)
+		require.True(t, initialKeyring.Keys[0].Staged)
+		require.Equal(t, "key1", initialKeyring.Keys[1].Name)
+		require.False(t, initialKeyring.Keys[1].Staged)
+		require.Equal(t, "AES-256", initialKeyring.Keys[1].Algorithm)
+
+		keyBs, err := base64.StdEncoding.DecodeString(initialKeyring.Keys[1].Secret)
+		require.NoError(t, err)
+		require.Len(t, keyBs, 32)
+
+		block, err := aes.NewCipher(keyBs)
+		require.NoError(t, err)
+
+		aead, err := cipher.NewGCM(block)
+		require.NoError(t, err)
+
+		nonce := make([]byte, aead.NonceSize())
+		_, err = rand.Read(nonce)
+		require.NoError(t, err)
+
+		sealed := aead.Seal(nil, nonce, []byte("plaintext"), nil)
+		opened, err := aead.Open(nil, nonce, sealed, nil)
+		require.NoError(t, err)
+		require.Equal(t, "plaintext", string(opened))
+
+		var encConfig struct {
+			Kind      string `json:"kind"`
+			Resources []struct {
+				Resources []string                     `json:"resources"`
+				Providers []map[string]json.RawMessage `json:"providers"`
+			} `json:"resources"`
+		}
+
+		err = yaml.Unmarshal(secret.Data["encryption-config.yaml"], &encConfig)
+		require.NoError(t, err)
+		require.Equal(t, "EncryptionConfiguration", encConfig.Kind)
+		require.Len(t, encConfig.Resources, 1)
+		require.Equal(t, []string{"secrets"}, encConfig.Resources[0].Resources)
+		require.Len(t, encConfig.Resources[0].Providers, 2)
+		// Primary key comes first, staged key is only listed for decryption
+		providerKeys := string(encConfig.Resources[0].Providers[0]["aescbc"])
+		require.Contains(t, providerKeys, initialKeyring.Keys[1].Secret)
+		require.Contains(t, providerKeys, initialKeyring.Keys[0].Secret)
+		require.Less(t, strings.Index(providerKeys, initialKeyring.Keys[1].Secret),
+			strings.Index(providerKeys, initialKeyring.Keys[0].Secret))
+		require.Contains(t, encConfig.Resources[0].Providers[1], "identity")
+	})
+
+	logger.Section("Check rotation", func() {
+		var keyring encryptionKeyringJSON
+
+		for i := 0; i < 90; i++ {
+			secret := waitForSecretObj(t, kubectl, "keyring")
+
+			err := json.Unmarshal(secret.Data["keyring.json"], &keyring)
+			require.NoError(t, err)
+
+			if keyring.Primary == "key3" {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.Equal(t, "key3", keyring.Primary, "Expected two rotations")
+		require.Len(t, keyring.Keys, 3, "Expected oldest key to be dropped")
+		require.Equal(t, "key4", keyring.Keys[0].Name)
+		require.True(t, keyring.Keys[0].Staged)
+		require.Equal(t, "key3", keyring.Keys[1].Name)
+		require.Equal(t, "key2", keyring.Keys[2].Name)
+		// Staged key is promoted as is
+		require.Equal(t, initialKeyring.Keys[0].Secret, keyring.Keys[2].Secret)
+	})
+
+	logger.Section("Delete", func() {
+		kapp.Run([]string{"delete", "-a", name})
+
+		_, err := kubectl.RunWithOpts([]string{"delete", "secret", "keyring-keys"},
+			RunOpts{AllowError: true})
+
+		if !strings.Contains(err.Error(), "(NotFound)") {
+			t.Fatalf("Expected NotFound error but was: %s", err)
+		}
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestHtpasswd(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: alice-password
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: bob-password
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Htpasswd
+metadata:
+  name: basic-auth
+spec:
+  bcryptCost: 5
+  users:
+  - username: alice
+    passwordRef:
+      name: alice-password
+  - username: bob
+    passwordRef:
+      name: bob-password
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Htpasswd
+metadata:
+  name: basic-auth-sha
+spec:
+  algorithm: sha
+  users:
+  - username: alice
+    passwordRef:
+      name: alice-password
+  secretTemplate:
+    type: Opaque
+    stringData:
+      htpasswd: $(auth)
+`
+
+	name := "test-htpasswd"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	alicePassword := string(waitForSecretObj(t, kubectl, "alice-password").Data["password"])
+	bobPassword := string(waitForSecretObj(t, kubectl, "bob-password").Data["password"])
+
+	var auth string
+
+	logger.Section("Check bcrypt htpasswd", func() {
+		secret := waitForSecretObj(t, kubectl, "basic-auth")
+		require.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+
+		auth = string(secret.Data["auth"])
+		hashes := parseHtpasswd(t, auth)
+		require.Len(t, hashes, 2)
+
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hashes["alice"]), []byte(alicePassword)))
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hashes["bob"]), []byte(bobPassword)))
+
+		cost, err := bcrypt.Cost([]byte(hashes["alice"]))
+		require.NoError(t, err)
+		require.Equal(t, 5, cost)
+	})
+
+	logger.Section("Check sha htpasswd", func() {
+		secret := waitForSecretObj(t, kubectl, "basic-auth-sha")
+
+		hashes := parseHtpasswd(t, string(secret.Data["htpasswd"]))
+		require.Len(t, hashes, 1)
+		require.True(t, strings.HasPrefix(hashes["alice"], "{SHA}"))
+	})
+
+	logger.Section("Re-render htpasswd when password rotates", func() {
+		kubectl.Run([]string{"patch", "secret", "bob-password", "--type", "merge",
+			"-p", `{"stringData":{"password":"rotated-password"}}`})
+
+		var hashes map[string]string
+
+		for i := 0; i < 30; i++ {
+			newAuth := string(waitForSecretObj(t, kubectl, "basic-auth").Data["auth"])
+			if newAuth != auth {
+				hashes = parseHtpasswd(t, newAuth)
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hashes["alice"]), []byte(alicePassword)))
+		require.NoError(t, bcrypt.CompareHashAndPassword([]byte(hashes["bob"]), []byte("rotated-password")))
+	})
+}
+
+func parseHtpasswd(t *testing.T, auth string) map[string]string {
+	hashes := map[string]string{}
+
+	for _, line := range strings.Split(strings.TrimSpace(auth), "\n") {
+		pieces := strings.SplitN(line, ":", 2)
+		require.Len(t, pieces, 2)
+		hashes[pieces[0]] = pieces[1]
+	}
+
+	return hashes
+}
@@ -0,0 +1,61 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestImmutableSecret(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: immutable-password
+spec:
+  secretTemplate:
+    type: Opaque
+    immutable: true
+    stringData:
+      password: $(value)
+`
+
+	name := "test-immutable-secret"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	secret := waitForSecretObj(t, kubectl, "immutable-password")
+	require.NotNil(t, secret.Immutable)
+	require.True(t, *secret.Immutable)
+
+	logger.Section("Recreate immutable secret when data changes", func() {
+		kubectl.Run([]string{"annotate", "password", "immutable-password", "secretgen.carvel.dev/rotate=1", "--overwrite"})
+
+		waitForRotation(t, kubectl, "password", "immutable-password", "1")
+
+		newSecret := waitForSecretObj(t, kubectl, "immutable-password")
+		require.NotEqual(t, secret.UID, newSecret.UID)
+		require.NotEqual(t, string(secret.Data["password"]), string(newSecret.Data["password"]))
+		require.NotNil(t, newSecret.Immutable)
+		require.True(t, *newSecret.Immutable)
+	})
+}
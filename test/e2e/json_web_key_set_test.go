@@ -0,0 +1,133 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestJSONWebKeySet(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	// Short intervals are used to observe rotation within a test
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: JSONWebKeySet
+metadata:
+  name: jwks
+spec:
+  algorithm: ES256
+  rotationInterval: 40s
+  overlap: 15s
+`
+
+	name := "test-json-web-key-set"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	var initialKids []string
+
+	logger.Section("Check initial keys", func() {
+		out := waitForSecret(t, kubectl, "jwks")
+
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(out), &secret)
+		require.NoError(t, err)
+		require.NotEmpty(t, secret.Data["key.pem"])
+
+		initialKids = jwksKeyIDs(t, kubectl)
+		require.Len(t, initialKids, 2, "Expected current and next keys")
+		require.Equal(t, string(secret.Data["kid"]), initialKids[0])
+	})
+
+	logger.Section("Check rotation", func() {
+		var kids []string
+
+		for i := 0; i < 90; i++ {
+			kids = jwksKeyIDs(t, kubectl)
+			if kids[0] != initialKids[0] {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.Len(t, kids, 3, "Expected current, next and previous keys")
+		require.Equal(t, initialKids[1], kids[0], "Expected next key to become current")
+		require.Equal(t, initialKids[0], kids[2], "Expected current key to become previous")
+
+		out := waitForSecret(t, kubectl, "jwks")
+
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(out), &secret)
+		require.NoError(t, err)
+		require.Equal(t, initialKids[1], string(secret.Data["kid"]))
+	})
+
+	logger.Section("Delete", func() {
+		kapp.Run([]string{"delete", "-a", name})
+
+		_, err := kubectl.RunWithOpts([]string{"delete", "configmap", "jwks"},
+			RunOpts{AllowError: true})
+
+		if !strings.Contains(err.Error(), "(NotFound)") {
+			t.Fatalf("Expected NotFound error but was: %s", err)
+		}
+	})
+}
+
+func jwksKeyIDs(t *testing.T, kubectl Kubectl) []string {
+	var lastErr error
+
+	for i := 0; i < 30; i++ {
+		var out string
+
+		out, lastErr = kubectl.RunWithOpts([]string{"get", "configmap", "jwks", "-o", "yaml"}, RunOpts{AllowError: true})
+		if lastErr == nil {
+			var configMap corev1.ConfigMap
+
+			err := yaml.Unmarshal([]byte(out), &configMap)
+			require.NoError(t, err)
+
+			var jwks struct {
+				Keys []map[string]string `json:"keys"`
+			}
+
+			err = json.Unmarshal([]byte(configMap.Data["jwks.json"]), &jwks)
+			require.NoError(t, err)
+
+			var kids []string
+			for _, key := range jwks.Keys {
+				require.Equal(t, "ES256", key["alg"])
+				kids = append(kids, key["kid"])
+			}
+			return kids
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected to find config map 'jwks' but did not: %s", lastErr)
+	panic("Unreachable")
+}
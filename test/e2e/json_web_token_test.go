@@ -0,0 +1,170 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONWebToken(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yamlTpl := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: jwt-secret
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: JSONWebToken
+metadata:
+  name: hmac-token
+spec:
+  signingKeyRef:
+    kind: Password
+    name: jwt-secret
+  claims:
+    role: ROLE
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: KeyPair
+metadata:
+  name: jwt-key-pair
+spec:
+  secretTemplate:
+    type: Opaque
+    stringData:
+      pub.pem: $(publicKey)
+      key.pem: $(privateKey)
+      kid: $(kid)
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: JSONWebToken
+metadata:
+  name: ec-token
+spec:
+  signingKeyRef:
+    kind: KeyPair
+    name: jwt-key-pair
+  claims:
+    sub: svc
+    level: 3
+    admin: false
+    aud: [billing, audit]
+  expiresIn: 1h
+`
+
+	name := "test-json-web-token"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(strings.Replace(yamlTpl, "ROLE", "anon", 1))})
+	})
+
+	var hmacToken string
+
+	logger.Section("Check HMAC signed token", func() {
+		passwordSecret := waitForSecretObj(t, kubectl, "jwt-secret")
+		hmacToken = string(waitForSecretObj(t, kubectl, "hmac-token").Data["token"])
+
+		header, claims, signingInput, sig := parseJWT(t, hmacToken)
+		require.Equal(t, "HS256", header["alg"])
+		require.Equal(t, "anon", claims["role"])
+		require.NotNil(t, claims["iat"])
+		require.Nil(t, claims["exp"])
+
+		mac := hmac.New(sha256.New, passwordSecret.Data["password"])
+		mac.Write([]byte(signingInput))
+		require.True(t, hmac.Equal(mac.Sum(nil), sig), "Expected valid signature")
+	})
+
+	logger.Section("Check ECDSA signed token", func() {
+		keySecret := waitForSecretObj(t, kubectl, "jwt-key-pair")
+		token := string(waitForSecretObj(t, kubectl, "ec-token").Data["token"])
+
+		header, claims, signingInput, sig := parseJWT(t, token)
+		require.Equal(t, "ES256", header["alg"])
+		require.Equal(t, string(keySecret.Data["kid"]), header["kid"])
+		require.Equal(t, "svc", claims["sub"])
+		require.Equal(t, float64(3), claims["level"])
+		require.Equal(t, false, claims["admin"])
+		require.Equal(t, []interface{}{"billing", "audit"}, claims["aud"])
+
+		exp := time.Unix(int64(claims["exp"].(float64)), 0)
+		require.WithinDuration(t, time.Now().Add(time.Hour), exp, 5*time.Minute)
+
+		block, _ := pem.Decode(keySecret.Data["pub.pem"])
+		require.NotNil(t, block)
+
+		pubKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		require.NoError(t, err)
+
+		digest := sha256.Sum256([]byte(signingInput))
+		require.True(t, ecdsa.Verify(pubKey.(*ecdsa.PublicKey), digest[:],
+			new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])), "Expected valid signature")
+	})
+
+	logger.Section("Re-mint token when claims change", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(strings.Replace(yamlTpl, "ROLE", "service_role", 1))})
+
+		var claims map[string]interface{}
+
+		for i := 0; i < 30; i++ {
+			token := string(waitForSecretObj(t, kubectl, "hmac-token").Data["token"])
+			if token != hmacToken {
+				_, claims, _, _ = parseJWT(t, token)
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.Equal(t, "service_role", claims["role"])
+	})
+}
+
+func parseJWT(t *testing.T, token string) (map[string]string, map[string]interface{}, string, []byte) {
+	parts := strings.Split(token, ".")
+	require.Len(t, parts, 3)
+
+	headerBs, err := base64.RawURLEncoding.DecodeString(parts[0])
+	require.NoError(t, err)
+
+	claimsBs, err := base64.RawURLEncoding.DecodeString(parts[1])
+	require.NoError(t, err)
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	require.NoError(t, err)
+
+	var header map[string]string
+	require.NoError(t, json.Unmarshal(headerBs, &header))
+
+	var claims map[string]interface{}
+	require.NoError(t, json.Unmarshal(claimsBs, &claims))
+
+	return header, claims, parts[0] + "." + parts[1], sig
+}
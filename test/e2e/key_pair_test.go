@@ -0,0 +1,115 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestKeyPair(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: KeyPair
+metadata:
+  name: key-pair-ecdsa
+spec:
+  curve: P-384
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: KeyPair
+metadata:
+  name: key-pair-ed25519
+spec:
+  algorithm: ed25519
+  secretTemplate:
+    type: Opaque
+    stringData:
+      key.pk8.pem: $(privateKeyPkcs8)
+      pub.pem: $(publicKey)
+      jwk.json: $(jwk)
+      pub-jwk.json: $(publicJwk)
+      kid: $(kid)
+`
+
+	name := "test-key-pair"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check ecdsa secret", func() {
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(waitForSecret(t, kubectl, "key-pair-ecdsa")), &secret)
+		require.NoError(t, err)
+		require.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+
+		key := parsePKCS8PrivateKey(t, secret.Data["key.pem"])
+		require.Equal(t, "P-384", key.(*ecdsa.PrivateKey).Curve.Params().Name)
+
+		block, _ := pem.Decode(secret.Data["pub.pem"])
+		require.NotNil(t, block)
+
+		pubKey, err := x509.ParsePKIXPublicKey(block.Bytes)
+		require.NoError(t, err)
+		require.True(t, key.(*ecdsa.PrivateKey).PublicKey.Equal(pubKey))
+	})
+
+	logger.Section("Check ed25519 secret", func() {
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(waitForSecret(t, kubectl, "key-pair-ed25519")), &secret)
+		require.NoError(t, err)
+
+		key := parsePKCS8PrivateKey(t, secret.Data["key.pk8.pem"])
+		_, ok := key.(ed25519.PrivateKey)
+		require.True(t, ok, "Expected ed25519 key")
+
+		var privateJWK, publicJWK map[string]string
+
+		require.NoError(t, json.Unmarshal(secret.Data["jwk.json"], &privateJWK))
+		require.NoError(t, json.Unmarshal(secret.Data["pub-jwk.json"], &publicJWK))
+
+		require.Equal(t, "OKP", publicJWK["kty"])
+		require.Equal(t, "Ed25519", publicJWK["crv"])
+		require.Equal(t, string(secret.Data["kid"]), publicJWK["kid"])
+		require.Equal(t, publicJWK["x"], privateJWK["x"])
+		require.NotEmpty(t, privateJWK["d"])
+		require.Empty(t, publicJWK["d"])
+	})
+}
+
+func parsePKCS8PrivateKey(t *testing.T, data []byte) interface{} {
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+	require.Equal(t, "PRIVATE KEY", block.Type)
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	require.NoError(t, err)
+
+	return key
+}
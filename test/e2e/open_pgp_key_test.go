@@ -0,0 +1,145 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestOpenPGPKey(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: OpenPGPKey
+metadata:
+  name: pgp-rsa
+spec:
+  name: Example
+  email: example@example.com
+  bits: 2048
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: pgp-ed25519-passphrase
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: OpenPGPKey
+metadata:
+  name: pgp-ed25519
+spec:
+  name: Release Signing
+  email: releases@example.com
+  algorithm: ed25519
+  expiry: 30d
+  passphraseRef:
+    name: pgp-ed25519-passphrase
+  secretTemplate:
+    type: Opaque
+    stringData:
+      private.asc: $(privateKey)
+      fingerprint: $(fingerprint)
+`
+
+	name := "test-open-pgp-key"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check unprotected rsa key", func() {
+		secret := waitForSecretObj(t, kubectl, "pgp-rsa")
+		require.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+
+		entity := readOpenPGPEntity(t, secret.Data["private.asc"])
+		require.False(t, entity.PrivateKey.Encrypted)
+		require.Contains(t, entity.Identities, "Example <example@example.com>")
+
+		bits, err := entity.PrimaryKey.BitLength()
+		require.NoError(t, err)
+		require.Equal(t, uint16(2048), bits)
+
+		pubEntity := readOpenPGPEntity(t, secret.Data["public.asc"])
+		require.Nil(t, pubEntity.PrivateKey)
+		require.Equal(t, entity.PrimaryKey.Fingerprint, pubEntity.PrimaryKey.Fingerprint)
+
+		out := kubectl.Run([]string{"get", "openpgpkey", "pgp-rsa", "-o", "jsonpath={.status.fingerprint}"})
+		require.Equal(t, fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint), out)
+	})
+
+	logger.Section("Check passphrase protected ed25519 key", func() {
+		secret := waitForSecretObj(t, kubectl, "pgp-ed25519")
+		passphraseSecret := waitForSecretObj(t, kubectl, "pgp-ed25519-passphrase")
+
+		entity := readOpenPGPEntity(t, secret.Data["private.asc"])
+		require.True(t, entity.PrivateKey.Encrypted)
+		require.Equal(t, fmt.Sprintf("%X", entity.PrimaryKey.Fingerprint), string(secret.Data["fingerprint"]))
+
+		err := entity.DecryptPrivateKeys(passphraseSecret.Data["password"])
+		require.NoError(t, err)
+
+		var sig bytes.Buffer
+		err = openpgp.ArmoredDetachSign(&sig, entity, strings.NewReader("payload"), nil)
+		require.NoError(t, err)
+
+		_, err = openpgp.CheckArmoredDetachedSignature(openpgp.EntityList{entity},
+			strings.NewReader("payload"), &sig, nil)
+		require.NoError(t, err)
+
+		for _, ident := range entity.Identities {
+			require.NotNil(t, ident.SelfSignature.KeyLifetimeSecs)
+			require.Equal(t, uint32(30*24*60*60), *ident.SelfSignature.KeyLifetimeSecs)
+		}
+	})
+
+	logger.Section("Check key is regenerated when expiry changes", func() {
+		secret := waitForSecretObj(t, kubectl, "pgp-ed25519")
+
+		kubectl.Run([]string{"patch", "openpgpkey", "pgp-ed25519", "--type=merge", "-p", `{"spec":{"expiry":"60d"}}`})
+
+		out := waitUntilSecretInNsPopulated(t, kubectl, "", "pgp-ed25519", func(s *corev1.Secret) bool {
+			return string(s.Data["fingerprint"]) != string(secret.Data["fingerprint"])
+		})
+
+		var regeneratedSecret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(out), &regeneratedSecret)
+		require.NoError(t, err)
+
+		entity := readOpenPGPEntity(t, regeneratedSecret.Data["private.asc"])
+		for _, ident := range entity.Identities {
+			require.NotNil(t, ident.SelfSignature.KeyLifetimeSecs)
+			require.Equal(t, uint32(60*24*60*60), *ident.SelfSignature.KeyLifetimeSecs)
+		}
+	})
+}
+
+func readOpenPGPEntity(t *testing.T, data []byte) *openpgp.Entity {
+	entities, err := openpgp.ReadArmoredKeyRing(bytes.NewReader(data))
+	require.NoError(t, err)
+	require.Len(t, entities, 1)
+
+	return entities[0]
+}
@@ -0,0 +1,89 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRandomToken(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: RandomToken
+metadata:
+  name: token-hex
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: RandomToken
+metadata:
+  name: token-base64url
+spec:
+  bytes: 48
+  encoding: base64url
+  secretTemplate:
+    type: Opaque
+    stringData:
+      token: $(value)
+      hex: $(hex)
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: RandomToken
+metadata:
+  name: token-uuid
+spec:
+  encoding: uuid
+`
+
+	name := "test-random-token"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check hex token", func() {
+		secret := waitForSecretObj(t, kubectl, "token-hex")
+		require.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+
+		bs, err := hex.DecodeString(string(secret.Data["token"]))
+		require.NoError(t, err)
+		require.Len(t, bs, 32)
+	})
+
+	logger.Section("Check base64url token", func() {
+		secret := waitForSecretObj(t, kubectl, "token-base64url")
+
+		bs, err := base64.RawURLEncoding.DecodeString(string(secret.Data["token"]))
+		require.NoError(t, err)
+		require.Len(t, bs, 48)
+		require.Equal(t, hex.EncodeToString(bs), string(secret.Data["hex"]))
+	})
+
+	logger.Section("Check uuid token", func() {
+		secret := waitForSecretObj(t, kubectl, "token-uuid")
+
+		uuidRegexp := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+		require.Regexp(t, uuidRegexp, string(secret.Data["token"]))
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func TestRollout(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: rollout-password
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: rollout-app
+  annotations:
+    secretgen.carvel.dev/rollout-on-secret-change: rollout-password
+spec:
+  selector:
+    matchLabels:
+      app: rollout-app
+  template:
+    metadata:
+      labels:
+        app: rollout-app
+    spec:
+      containers:
+      - name: app
+        image: docker.io/dkalinin/k8s-simple-app@sha256:4c8b96d4fffdfae29258d94a22ae4ad1fe36139d47288b8960d9958d1e63a9d0
+        env:
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: rollout-password
+              key: password
+`
+
+	name := "test-rollout"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Record baseline checksum without rollout", func() {
+		deployment := waitForWorkloadChecksum(t, kubectl, "rollout-app")
+		require.Empty(t, deployment.Spec.Template.Annotations["secretgen.carvel.dev/secrets-checksum"])
+	})
+
+	logger.Section("Add checksum to pod template when secret changes", func() {
+		kubectl.Run([]string{"annotate", "password", "rollout-password", "secretgen.carvel.dev/rotate=incident-1", "--overwrite"})
+		waitForRotation(t, kubectl, "password", "rollout-password", "incident-1")
+
+		checksum := waitForPodTemplateChecksum(t, kubectl, "rollout-app", "")
+
+		kubectl.Run([]string{"annotate", "password", "rollout-password", "secretgen.carvel.dev/rotate=incident-2", "--overwrite"})
+		waitForRotation(t, kubectl, "password", "rollout-password", "incident-2")
+
+		waitForPodTemplateChecksum(t, kubectl, "rollout-app", checksum)
+	})
+}
+
+func waitForWorkloadChecksum(t *testing.T, kubectl Kubectl, name string) appsv1.Deployment {
+	for i := 0; i < 30; i++ {
+		var deployment appsv1.Deployment
+
+		out := kubectl.Run([]string{"get", "deployment", name, "-o", "yaml"})
+		err := yaml.Unmarshal([]byte(out), &deployment)
+		require.NoError(t, err)
+
+		if len(deployment.Annotations["secretgen.carvel.dev/secrets-checksum"]) > 0 {
+			return deployment
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected deployment '%s' to have checksum recorded", name)
+	panic("Unreachable")
+}
+
+func waitForPodTemplateChecksum(t *testing.T, kubectl Kubectl, name, previousChecksum string) string {
+	for i := 0; i < 30; i++ {
+		var deployment appsv1.Deployment
+
+		out := kubectl.Run([]string{"get", "deployment", name, "-o", "yaml"})
+		err := yaml.Unmarshal([]byte(out), &deployment)
+		require.NoError(t, err)
+
+		checksum := deployment.Spec.Template.Annotations["secretgen.carvel.dev/secrets-checksum"]
+		if len(checksum) > 0 && checksum != previousChecksum {
+			return checksum
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected deployment '%s' pod template checksum to change from '%s'", name, previousChecksum)
+	panic("Unreachable")
+}
@@ -0,0 +1,167 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+)
+
+func TestRotation(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: rotate-password
+  annotations:
+    secretgen.carvel.dev/rotate: initial
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Certificate
+metadata:
+  name: rotate-cert
+spec:
+  isCA: true
+  commonName: Rotated CA
+`
+
+	name := "test-rotation"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	password := string(waitForSecretObj(t, kubectl, "rotate-password").Data["password"])
+	certificate := string(waitForSecretObj(t, kubectl, "rotate-cert").Data["crt.pem"])
+
+	logger.Section("Record token present at creation", func() {
+		rotation := waitForRotation(t, kubectl, "password", "rotate-password", "initial")
+		require.False(t, rotation.RotatedAt.IsZero())
+		require.Equal(t, password, string(waitForSecretObj(t, kubectl, "rotate-password").Data["password"]))
+	})
+
+	logger.Section("Regenerate when token changes", func() {
+		kubectl.Run([]string{"annotate", "password", "rotate-password", "secretgen.carvel.dev/rotate=incident-1", "--overwrite"})
+		kubectl.Run([]string{"annotate", "certificate", "rotate-cert", "secretgen.carvel.dev/rotate=incident-1", "--overwrite"})
+
+		waitForRotation(t, kubectl, "password", "rotate-password", "incident-1")
+		waitForRotation(t, kubectl, "certificate", "rotate-cert", "incident-1")
+
+		newPassword := string(waitForSecretObj(t, kubectl, "rotate-password").Data["password"])
+		require.NotEqual(t, password, newPassword)
+		password = newPassword
+
+		newCertificate := string(waitForSecretObj(t, kubectl, "rotate-cert").Data["crt.pem"])
+		require.NotEqual(t, certificate, newCertificate)
+	})
+
+	logger.Section("Do not regenerate again for processed token", func() {
+		// Changing unrelated annotation triggers reconciliation
+		kubectl.Run([]string{"annotate", "password", "rotate-password", "unrelated=true", "--overwrite"})
+		time.Sleep(5 * time.Second)
+
+		require.Equal(t, password, string(waitForSecretObj(t, kubectl, "rotate-password").Data["password"]))
+	})
+}
+
+func waitForRotation(t *testing.T, kubectl Kubectl, resource, name, token string) sgv1alpha1.RotationStatus {
+	var status struct {
+		Status struct {
+			LastRotation *sgv1alpha1.RotationStatus `json:"lastRotation"`
+		} `json:"status"`
+	}
+
+	for i := 0; i < 30; i++ {
+		out := kubectl.Run([]string{"get", resource, name, "-o", "yaml"})
+
+		err := yaml.Unmarshal([]byte(out), &status)
+		require.NoError(t, err)
+
+		if rotation := status.Status.LastRotation; rotation != nil && rotation.Token == token {
+			return *rotation
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected %s '%s' to process rotation token '%s'", resource, name, token)
+	panic("Unreachable")
+}
+
+func TestRotationGracePeriod(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: grace-password
+spec:
+  rotationGracePeriod: 20s
+  secretTemplate:
+    type: Opaque
+    stringData:
+      current: $(value)
+      previous: $(previous.value)
+`
+
+	name := "test-rotation-grace-period"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	secret := waitForSecretObj(t, kubectl, "grace-password")
+	password := string(secret.Data["current"])
+
+	logger.Section("Previous value is omitted before rotation", func() {
+		require.NotContains(t, secret.Data, "previous")
+	})
+
+	logger.Section("Keep previous value after rotation", func() {
+		kubectl.Run([]string{"annotate", "password", "grace-password", "secretgen.carvel.dev/rotate=incident-1", "--overwrite"})
+		waitForRotation(t, kubectl, "password", "grace-password", "incident-1")
+
+		secret := waitForRepairedSecret(t, kubectl, "grace-password", "current", password)
+		require.Equal(t, password, string(secret.Data["previous"]))
+	})
+
+	logger.Section("Drop previous value once grace period ends", func() {
+		secret := waitForRepairedSecret(t, kubectl, "grace-password", "previous", password)
+		require.NotContains(t, secret.Data, "previous")
+		require.NotEqual(t, password, string(secret.Data["current"]))
+
+		uid := kubectl.Run([]string{"get", "password", "grace-password", "-o", "jsonpath={.metadata.uid}"})
+		out := kubectl.Run([]string{"get", "secrets", "-l", "secretgen.k14s.io/previous-values-of=" + uid, "-o", "name"})
+		require.Empty(t, strings.TrimSpace(out))
+	})
+}
@@ -4,10 +4,15 @@
 package e2e
 
 import (
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
 	"strings"
 	"testing"
 
 	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 )
 
@@ -71,3 +76,70 @@ spec: {}
 		}
 	})
 }
+
+func TestRSAKeyBitsAndFormats(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: RSAKey
+metadata:
+  name: rsa-key-formats
+spec:
+  bits: 3072
+  secretTemplate:
+    type: Opaque
+    stringData:
+      key.pk8.pem: $(privateKeyPkcs8)
+      pub.pem: $(publicKey)
+      jwk.json: $(jwk)
+      pub-jwk.json: $(publicJwk)
+      kid: $(kid)
+`
+
+	name := "test-rsa-key-formats"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check secret", func() {
+		out := waitForSecret(t, kubectl, "rsa-key-formats")
+
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(out), &secret)
+		require.NoError(t, err)
+
+		block, _ := pem.Decode(secret.Data["key.pk8.pem"])
+		require.NotNil(t, block)
+		require.Equal(t, "PRIVATE KEY", block.Type)
+
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		require.NoError(t, err)
+		require.Equal(t, 3072, key.(*rsa.PrivateKey).N.BitLen())
+
+		var privateJWK, publicJWK map[string]string
+
+		require.NoError(t, json.Unmarshal(secret.Data["jwk.json"], &privateJWK))
+		require.NoError(t, json.Unmarshal(secret.Data["pub-jwk.json"], &publicJWK))
+
+		require.Equal(t, "RSA", publicJWK["kty"])
+		require.Equal(t, string(secret.Data["kid"]), publicJWK["kid"])
+		require.Equal(t, publicJWK["kid"], privateJWK["kid"])
+		require.Equal(t, publicJWK["n"], privateJWK["n"])
+		require.NotEmpty(t, privateJWK["d"])
+		require.Empty(t, publicJWK["d"])
+	})
+}
@@ -0,0 +1,91 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSecretAdoption(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	existingSecretYaml := `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: adopt-password
+type: Opaque
+stringData:
+  password: existing-password
+  url: postgres://user:existing-password@db
+`
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: adopt-password
+spec:
+  adoptExisting: true
+  secretTemplate:
+    type: Opaque
+    stringData:
+      password: $(value)
+      url: postgres://user:$(value)@db
+`
+
+	name := "test-secret-adoption"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+		kubectl.RunWithOpts([]string{"delete", "secret", "adopt-password"}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Create existing secret", func() {
+		kubectl.RunWithOpts([]string{"create", "-f", "-"},
+			RunOpts{StdinReader: strings.NewReader(existingSecretYaml)})
+	})
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Adopt secret keeping its values", func() {
+		secret := waitForAdoptedSecret(t, kubectl, "adopt-password")
+
+		controller := metav1.GetControllerOf(&secret)
+		require.NotNil(t, controller)
+		require.Equal(t, "Password", controller.Kind)
+		require.Equal(t, "adopt-password", controller.Name)
+
+		require.Equal(t, "existing-password", string(secret.Data["password"]))
+		require.Equal(t, "postgres://user:existing-password@db", string(secret.Data["url"]))
+	})
+}
+
+func waitForAdoptedSecret(t *testing.T, kubectl Kubectl, name string) corev1.Secret {
+	for i := 0; i < 30; i++ {
+		secret := waitForSecretObj(t, kubectl, name)
+		if metav1.GetControllerOf(&secret) != nil {
+			return secret
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected secret '%s' to be adopted", name)
+	panic("Unreachable")
+}
@@ -0,0 +1,152 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	sgv1alpha1 "github.com/vmware-tanzu/carvel-secretgen-controller/pkg/apis/secretgen/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSecretRepair(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: Password
+metadata:
+  name: repair-password
+spec:
+  secretTemplate:
+    type: Opaque
+    stringData:
+      password: $(value)
+      url: postgres://user:$(value)@db
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: SSHKey
+metadata:
+  name: repair-ssh-key
+spec:
+  type: ed25519
+`
+
+	name := "test-secret-repair"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	password := string(waitForSecretObj(t, kubectl, "repair-password").Data["password"])
+	require.NotEmpty(t, password)
+
+	logger.Section("Restore modified key keeping password", func() {
+		kubectl.Run([]string{"patch", "secret", "repair-password", "--type", "merge",
+			"-p", `{"stringData":{"url":"tampered"}}`})
+
+		secret := waitForRepairedSecret(t, kubectl, "repair-password", "url", "tampered")
+		require.Equal(t, password, string(secret.Data["password"]))
+		require.Equal(t, "postgres://user:"+password+"@db", string(secret.Data["url"]))
+
+		drift := waitForSecretDrift(t, kubectl, "password", "repair-password", sgv1alpha1.SecretDriftReasonModified, []string{"url"})
+		require.True(t, drift.ValuesPreserved)
+	})
+
+	logger.Section("Regenerate password when it cannot be recovered", func() {
+		kubectl.Run([]string{"patch", "secret", "repair-password", "--type", "merge",
+			"-p", `{"stringData":{"password":"tampered"}}`})
+
+		secret := waitForRepairedSecret(t, kubectl, "repair-password", "password", "tampered")
+		newPassword := string(secret.Data["password"])
+		require.NotEqual(t, password, newPassword)
+		require.Equal(t, "postgres://user:"+newPassword+"@db", string(secret.Data["url"]))
+
+		drift := waitForSecretDrift(t, kubectl, "password", "repair-password", sgv1alpha1.SecretDriftReasonModified, []string{"password"})
+		require.False(t, drift.ValuesPreserved)
+	})
+
+	logger.Section("Regenerate deleted secret", func() {
+		kubectl.Run([]string{"delete", "secret", "repair-password"})
+
+		secret := waitForSecretObj(t, kubectl, "repair-password")
+		require.NotEmpty(t, secret.Data["password"])
+
+		drift := waitForSecretDrift(t, kubectl, "password", "repair-password", sgv1alpha1.SecretDriftReasonDeleted, nil)
+		require.False(t, drift.ValuesPreserved)
+	})
+
+	logger.Section("Restore ssh key secret keeping private key", func() {
+		secret := waitForSecretObj(t, kubectl, "repair-ssh-key")
+		privateKey := string(secret.Data["ssh-privatekey"])
+		authorizedKey := string(secret.Data["ssh-authorizedkey"])
+		require.NotEmpty(t, privateKey)
+
+		kubectl.Run([]string{"patch", "secret", "repair-ssh-key", "--type", "merge",
+			"-p", `{"stringData":{"ssh-authorizedkey":"tampered","extra":"value"}}`})
+
+		secret = waitForRepairedSecret(t, kubectl, "repair-ssh-key", "ssh-authorizedkey", "tampered")
+		require.Equal(t, privateKey, string(secret.Data["ssh-privatekey"]))
+		require.Equal(t, authorizedKey, string(secret.Data["ssh-authorizedkey"]))
+		require.NotContains(t, secret.Data, "extra")
+
+		drift := waitForSecretDrift(t, kubectl, "sshkey", "repair-ssh-key", sgv1alpha1.SecretDriftReasonModified, []string{"extra", "ssh-authorizedkey"})
+		require.True(t, drift.ValuesPreserved)
+	})
+}
+
+func waitForRepairedSecret(t *testing.T, kubectl Kubectl, name, key, tamperedVal string) corev1.Secret {
+	var secret corev1.Secret
+
+	for i := 0; i < 30; i++ {
+		secret = waitForSecretObj(t, kubectl, name)
+		if string(secret.Data[key]) != tamperedVal {
+			return secret
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected secret '%s' key '%s' to be repaired", name, key)
+	panic("Unreachable")
+}
+
+func waitForSecretDrift(t *testing.T, kubectl Kubectl, resource, name, reason string, keys []string) sgv1alpha1.SecretDriftStatus {
+	var status struct {
+		Status struct {
+			LastSecretDrift *sgv1alpha1.SecretDriftStatus `json:"lastSecretDrift"`
+		} `json:"status"`
+	}
+
+	for i := 0; i < 30; i++ {
+		out := kubectl.Run([]string{"get", resource, name, "-o", "yaml"})
+
+		err := yaml.Unmarshal([]byte(out), &status)
+		require.NoError(t, err)
+
+		drift := status.Status.LastSecretDrift
+		if drift != nil && drift.Reason == reason && reflect.DeepEqual(drift.Keys, keys) {
+			return *drift
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected %s '%s' to report secret drift '%s' of keys %v", resource, name, reason, keys)
+	panic("Unreachable")
+}
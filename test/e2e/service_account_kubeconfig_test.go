@@ -0,0 +1,135 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestServiceAccountKubeconfig(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: ci
+  annotations:
+    secretgen.carvel.dev/allow-service-account-kubeconfig: "true"
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: ServiceAccountKubeconfig
+metadata:
+  name: ci-kubeconfig
+spec:
+  serviceAccountName: ci
+  expiration: 1h
+  server: https://k8s.example.com:6443
+  clusterName: test
+`
+
+	name := "test-service-account-kubeconfig"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	var token string
+
+	logger.Section("Check kubeconfig", func() {
+		secret := waitForSecretObj(t, kubectl, "ci-kubeconfig")
+
+		config, err := clientcmd.Load(secret.Data["kubeconfig"])
+		require.NoError(t, err)
+		require.Equal(t, "ci@test", config.CurrentContext)
+
+		kubeContext := config.Contexts[config.CurrentContext]
+		require.NotNil(t, kubeContext)
+		require.Equal(t, env.Namespace, kubeContext.Namespace)
+
+		cluster := config.Clusters[kubeContext.Cluster]
+		require.NotNil(t, cluster)
+		require.Equal(t, "https://k8s.example.com:6443", cluster.Server)
+		require.NotEmpty(t, cluster.CertificateAuthorityData)
+
+		user := config.AuthInfos[kubeContext.AuthInfo]
+		require.NotNil(t, user)
+
+		token = user.Token
+		require.Equal(t, "system:serviceaccount:"+env.Namespace+":ci", reviewSAKubeconfigToken(t, kubectl, token))
+	})
+
+	logger.Section("Recreate secret when deleted", func() {
+		kubectl.Run([]string{"delete", "secret", "ci-kubeconfig"})
+
+		secret := waitForSecretObj(t, kubectl, "ci-kubeconfig")
+		require.Contains(t, secret.Data, "kubeconfig")
+	})
+
+	logger.Section("Reissue token when service account is recreated", func() {
+		kubectl.Run([]string{"delete", "serviceaccount", "ci"})
+		kubectl.Run([]string{"create", "serviceaccount", "ci"})
+		kubectl.Run([]string{"annotate", "serviceaccount", "ci", "secretgen.carvel.dev/allow-service-account-kubeconfig=true"})
+
+		var newToken string
+
+		for i := 0; i < 30; i++ {
+			secret := waitForSecretObj(t, kubectl, "ci-kubeconfig")
+
+			config, err := clientcmd.Load(secret.Data["kubeconfig"])
+			require.NoError(t, err)
+
+			newToken = config.AuthInfos["ci"].Token
+			if newToken != token {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.NotEqual(t, token, newToken)
+		require.Equal(t, "system:serviceaccount:"+env.Namespace+":ci", reviewSAKubeconfigToken(t, kubectl, newToken))
+
+		kubectl.Run([]string{"delete", "serviceaccount", "ci"})
+	})
+}
+
+// reviewSAKubeconfigToken returns username token authenticates as
+func reviewSAKubeconfigToken(t *testing.T, kubectl Kubectl, token string) string {
+	reviewBs, err := json.Marshal(authv1.TokenReview{
+		TypeMeta: metav1.TypeMeta{APIVersion: "authentication.k8s.io/v1", Kind: "TokenReview"},
+		Spec:     authv1.TokenReviewSpec{Token: token},
+	})
+	require.NoError(t, err)
+
+	out, err := kubectl.RunWithOpts([]string{"create", "-f", "-", "-o", "json"},
+		RunOpts{NoNamespace: true, StdinReader: strings.NewReader(string(reviewBs))})
+	require.NoError(t, err)
+
+	var review authv1.TokenReview
+
+	err = json.Unmarshal([]byte(out), &review)
+	require.NoError(t, err)
+	require.True(t, review.Status.Authenticated, "Expected token to be authenticated: %s", review.Status.Error)
+
+	return review.Status.User.Username
+}
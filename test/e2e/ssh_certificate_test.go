@@ -0,0 +1,163 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSSHCertificate(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: SSHKey
+metadata:
+  name: ssh-ca
+spec:
+  type: ed25519
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: SSHCertificate
+metadata:
+  name: ssh-user-cert
+spec:
+  caRef:
+    name: ssh-ca
+  principals:
+  - deploy
+  validity: 1d
+  criticalOptions:
+    force-command: /bin/true
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: SSHCertificate
+metadata:
+  name: ssh-host-cert
+spec:
+  caRef:
+    name: ssh-ca
+  certType: host
+  principals:
+  - bastion.example.com
+  secretTemplate:
+    type: Opaque
+    stringData:
+      key: $(privateKey)
+      cert: $(certificate)
+      known_hosts: $(caKnownHosts)
+`
+
+	name := "test-ssh-certificate"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	var caSecret corev1.Secret
+
+	logger.Section("Check user certificate", func() {
+		err := yaml.Unmarshal([]byte(waitForSecret(t, kubectl, "ssh-ca")), &caSecret)
+		require.NoError(t, err)
+
+		caPubKey, _, _, _, err := ssh.ParseAuthorizedKey(caSecret.Data["ssh-authorizedkey"])
+		require.NoError(t, err)
+
+		var secret corev1.Secret
+
+		err = yaml.Unmarshal([]byte(waitForSecret(t, kubectl, "ssh-user-cert")), &secret)
+		require.NoError(t, err)
+		require.Equal(t, corev1.SecretTypeSSHAuth, secret.Type)
+		require.Equal(t, string(ssh.MarshalAuthorizedKey(caPubKey)), string(secret.Data["ca.pub"]))
+
+		cert := parseSSHCertificate(t, secret.Data["ssh-certificate"])
+		require.Equal(t, uint32(ssh.UserCert), cert.CertType)
+		require.Equal(t, []string{"deploy"}, cert.ValidPrincipals)
+		require.Equal(t, "/bin/true", cert.CriticalOptions["force-command"])
+		require.Contains(t, cert.Extensions, "permit-pty")
+
+		checker := ssh.CertChecker{
+			IsUserAuthority: func(auth ssh.PublicKey) bool {
+				return string(auth.Marshal()) == string(caPubKey.Marshal())
+			},
+			SupportedCriticalOptions: []string{"force-command"},
+		}
+		err = checker.CheckCert("deploy", cert)
+		require.NoError(t, err)
+
+		signer, err := ssh.ParsePrivateKey(secret.Data["ssh-privatekey"])
+		require.NoError(t, err)
+		require.Equal(t, string(signer.PublicKey().Marshal()), string(cert.Key.Marshal()))
+	})
+
+	logger.Section("Check host certificate", func() {
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(waitForSecret(t, kubectl, "ssh-host-cert")), &secret)
+		require.NoError(t, err)
+
+		cert := parseSSHCertificate(t, secret.Data["cert"])
+		require.Equal(t, uint32(ssh.HostCert), cert.CertType)
+
+		marker, hosts, caPubKey, _, _, err := ssh.ParseKnownHosts(secret.Data["known_hosts"])
+		require.NoError(t, err)
+		require.Equal(t, "cert-authority", marker)
+		require.Equal(t, []string{"bastion.example.com"}, hosts)
+		require.Equal(t, string(cert.SignatureKey.Marshal()), string(caPubKey.Marshal()))
+	})
+
+	logger.Section("Check certificate is reissued when CA rotates", func() {
+		kubectl.Run([]string{"annotate", "sshkey", "ssh-ca", "secretgen.carvel.dev/rotate=incident-1", "--overwrite"})
+
+		var rotatedCASecret corev1.Secret
+
+		out := waitUntilSecretInNsPopulated(t, kubectl, "", "ssh-ca", func(secret *corev1.Secret) bool {
+			return string(secret.Data["ssh-authorizedkey"]) != string(caSecret.Data["ssh-authorizedkey"])
+		})
+		err := yaml.Unmarshal([]byte(out), &rotatedCASecret)
+		require.NoError(t, err)
+
+		caPubKey, _, _, _, err := ssh.ParseAuthorizedKey(rotatedCASecret.Data["ssh-authorizedkey"])
+		require.NoError(t, err)
+
+		out = waitUntilSecretInNsPopulated(t, kubectl, "", "ssh-user-cert", func(secret *corev1.Secret) bool {
+			return string(secret.Data["ca.pub"]) == string(ssh.MarshalAuthorizedKey(caPubKey))
+		})
+
+		var secret corev1.Secret
+
+		err = yaml.Unmarshal([]byte(out), &secret)
+		require.NoError(t, err)
+
+		cert := parseSSHCertificate(t, secret.Data["ssh-certificate"])
+		require.Equal(t, string(caPubKey.Marshal()), string(cert.SignatureKey.Marshal()))
+	})
+}
+
+func parseSSHCertificate(t *testing.T, data []byte) *ssh.Certificate {
+	pubKey, _, _, _, err := ssh.ParseAuthorizedKey(data)
+	require.NoError(t, err)
+
+	cert, ok := pubKey.(*ssh.Certificate)
+	require.True(t, ok, "Expected to be a certificate")
+
+	return cert
+}
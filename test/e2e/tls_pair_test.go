@@ -0,0 +1,172 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestTLSPair(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: sg-tls-pair-client
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: TLSPair
+metadata:
+  name: db
+spec:
+  server:
+    alternativeNames:
+    - db.svc.cluster.local
+  clients:
+  - name: app1
+    export:
+      toNamespace: sg-tls-pair-client
+  - name: app2
+    secretTemplate:
+      type: kubernetes.io/tls
+      stringData:
+        tls.crt: $(certificate)
+        tls.key: $(privateKey)
+        ca.crt: $(ca)
+---
+apiVersion: secretgen.carvel.dev/v1alpha1
+kind: SecretImport
+metadata:
+  name: db-client-app1
+  namespace: sg-tls-pair-client
+spec:
+  fromNamespace: ` + env.Namespace + `
+`
+
+	name := "test-tls-pair"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	var caSecret, serverSecret, client1Secret, client2Secret corev1.Secret
+
+	logger.Section("Check secrets", func() {
+		for _, item := range []struct {
+			Name   string
+			Secret *corev1.Secret
+		}{
+			{"db-ca", &caSecret},
+			{"db-server", &serverSecret},
+			{"db-client-app1", &client1Secret},
+			{"db-client-app2", &client2Secret},
+		} {
+			out := waitForSecret(t, kubectl, item.Name)
+
+			err := yaml.Unmarshal([]byte(out), item.Secret)
+			require.NoError(t, err)
+		}
+
+		require.NotEmpty(t, caSecret.Data["crt.pem"])
+		require.NotEmpty(t, caSecret.Data["key.pem"])
+		require.Equal(t, corev1.SecretType("kubernetes.io/tls"), client2Secret.Type)
+		require.NotEmpty(t, client2Secret.Data["tls.crt"])
+	})
+
+	logger.Section("Check certificates", func() {
+		roots := x509.NewCertPool()
+		require.True(t, roots.AppendCertsFromPEM(caSecret.Data["crt.pem"]))
+		require.Equal(t, caSecret.Data["crt.pem"], serverSecret.Data["ca.pem"])
+
+		serverCert := parseTLSPairCert(t, serverSecret.Data["crt.pem"])
+		_, err := serverCert.Verify(x509.VerifyOptions{
+			DNSName:   "db.svc.cluster.local",
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		require.NoError(t, err)
+
+		clientCert := parseTLSPairCert(t, client1Secret.Data["crt.pem"])
+		_, err = clientCert.Verify(x509.VerifyOptions{
+			Roots:     roots,
+			KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		require.NoError(t, err)
+
+		_, err = tls.X509KeyPair(client1Secret.Data["crt.pem"], client1Secret.Data["key.pem"])
+		require.NoError(t, err)
+	})
+
+	logger.Section("Check exported client secret", func() {
+		out := waitForSecretInNs(t, kubectl, "sg-tls-pair-client", "db-client-app1")
+
+		var secret corev1.Secret
+
+		err := yaml.Unmarshal([]byte(out), &secret)
+		require.NoError(t, err)
+		require.Equal(t, client1Secret.Data, secret.Data)
+	})
+
+	logger.Section("Reissue server certificate and delete removed client secret", func() {
+		yaml2 := strings.Replace(yaml1, "    - db.svc.cluster.local\n", "    - db.svc.cluster.local\n    - db2.svc.cluster.local\n", 1)
+		yaml2 = yaml2[:strings.Index(yaml2, "  - name: app2")] + yaml2[strings.Index(yaml2, "---\napiVersion: secretgen.carvel.dev"):]
+
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml2)})
+
+		secret := waitForRepairedSecret(t, kubectl, "db-server", "crt.pem", string(serverSecret.Data["crt.pem"]))
+		require.Contains(t, parseTLSPairCert(t, secret.Data["crt.pem"]).DNSNames, "db2.svc.cluster.local")
+
+		for i := 0; ; i++ {
+			_, err := kubectl.RunWithOpts([]string{"get", "secret", "db-client-app2"}, RunOpts{AllowError: true})
+			if err != nil && strings.Contains(err.Error(), "(NotFound)") {
+				break
+			}
+			require.Less(t, i, 30, "Expected secret 'db-client-app2' to be deleted")
+			time.Sleep(time.Second)
+		}
+	})
+
+	logger.Section("Delete", func() {
+		kapp.Run([]string{"delete", "-a", name})
+
+		_, err := kubectl.RunWithOpts([]string{"delete", "secret", "db-server"},
+			RunOpts{AllowError: true})
+
+		if !strings.Contains(err.Error(), "(NotFound)") {
+			t.Fatalf("Expected NotFound error but was: %s", err)
+		}
+	})
+}
+
+func parseTLSPairCert(t *testing.T, data []byte) *x509.Certificate {
+	block, _ := pem.Decode(data)
+	require.NotNil(t, block)
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+
+	return cert
+}
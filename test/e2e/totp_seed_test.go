@@ -0,0 +1,105 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"bytes"
+	"encoding/base32"
+	"image/png"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestTOTPSeed(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: TOTPSeed
+metadata:
+  name: totp-default
+spec:
+  issuer: Admin Console
+  account: admin@example.com
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: TOTPSeed
+metadata:
+  name: totp-sha256
+spec:
+  issuer: Admin Console
+  account: ops@example.com
+  algorithm: SHA256
+  digits: 8
+  period: 60
+  secretTemplate:
+    type: Opaque
+    stringData:
+      TOTP_SECRET: $(seed)
+      TOTP_URI: $(uri)
+`
+
+	name := "test-totp-seed"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	logger.Section("Check default seed", func() {
+		secret := waitForSecretObj(t, kubectl, "totp-default")
+		require.Equal(t, corev1.SecretTypeOpaque, secret.Type)
+
+		seed := string(secret.Data["seed"])
+		seedBytes, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(seed)
+		require.NoError(t, err)
+		require.Len(t, seedBytes, 20)
+
+		uri, err := url.Parse(string(secret.Data["uri"]))
+		require.NoError(t, err)
+		require.Equal(t, "otpauth", uri.Scheme)
+		require.Equal(t, "totp", uri.Host)
+		require.Equal(t, "/Admin Console:admin@example.com", uri.Path)
+		require.Equal(t, url.Values{
+			"secret":    []string{seed},
+			"issuer":    []string{"Admin Console"},
+			"algorithm": []string{"SHA1"},
+			"digits":    []string{"6"},
+			"period":    []string{"30"},
+		}, uri.Query())
+
+		img, err := png.Decode(bytes.NewReader(secret.Data["qr.png"]))
+		require.NoError(t, err)
+		require.Equal(t, 256, img.Bounds().Dx())
+	})
+
+	logger.Section("Check templated seed", func() {
+		secret := waitForSecretObj(t, kubectl, "totp-sha256")
+		require.Len(t, secret.Data, 2)
+
+		seedBytes, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(string(secret.Data["TOTP_SECRET"]))
+		require.NoError(t, err)
+		require.Len(t, seedBytes, 32)
+
+		uri, err := url.Parse(string(secret.Data["TOTP_URI"]))
+		require.NoError(t, err)
+		require.Equal(t, "SHA256", uri.Query().Get("algorithm"))
+		require.Equal(t, "8", uri.Query().Get("digits"))
+		require.Equal(t, "60", uri.Query().Get("period"))
+	})
+}
@@ -16,6 +16,19 @@ func waitForSecret(t *testing.T, kubectl Kubectl, name string) string {
 	return waitForSecretInNs(t, kubectl, "", name)
 }
 
+func waitForSecretObj(t *testing.T, kubectl Kubectl, name string) corev1.Secret {
+	return waitForSecretObjInNs(t, kubectl, "", name)
+}
+
+func waitForSecretObjInNs(t *testing.T, kubectl Kubectl, nsName, name string) corev1.Secret {
+	var secret corev1.Secret
+
+	err := yaml.Unmarshal([]byte(waitForSecretInNs(t, kubectl, nsName, name)), &secret)
+	require.NoError(t, err)
+
+	return secret
+}
+
 func waitForSecretInNs(t *testing.T, kubectl Kubectl, nsName, name string) string {
 	var lastErr error
 
@@ -40,6 +53,22 @@ func waitForSecretInNs(t *testing.T, kubectl Kubectl, nsName, name string) strin
 	panic("Unreachable")
 }
 
+func waitForConfigMap(t *testing.T, kubectl Kubectl, name string) string {
+	var lastErr error
+
+	for i := 0; i < 30; i++ {
+		var out string
+		out, lastErr = kubectl.RunWithOpts([]string{"get", "configmap", name, "-o", "yaml"}, RunOpts{AllowError: true})
+		if lastErr == nil {
+			return out
+		}
+		time.Sleep(time.Second)
+	}
+
+	t.Fatalf("Expected to find config map '%s' but did not: %s", name, lastErr)
+	panic("Unreachable")
+}
+
 func waitForSecretNotInNs(kubectl Kubectl, nsName, name string) bool {
 	var lastErr error
 
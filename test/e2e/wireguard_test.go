@@ -0,0 +1,133 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: Apache-2.0
+
+package e2e
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestWireGuard(t *testing.T) {
+	env := BuildEnv(t)
+	logger := Logger{}
+	kapp := Kapp{t, env.Namespace, logger}
+	kubectl := Kubectl{t, env.Namespace, logger}
+
+	yaml1 := `
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: WireGuardKey
+metadata:
+  name: wg-gateway
+spec: {}
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: WireGuardKey
+metadata:
+  name: wg-peer
+spec:
+  presharedKey: true
+---
+apiVersion: secretgen.k14s.io/v1alpha1
+kind: WireGuardConfig
+metadata:
+  name: wg-config
+spec:
+  interface:
+    privateKeyRef:
+      name: wg-gateway
+    addresses:
+    - 10.10.0.1/24
+    listenPort: 51820
+  peers:
+  - name: peer
+    publicKeyRef:
+      name: wg-peer
+    presharedKeyRef:
+      name: wg-peer
+    allowedIPs:
+    - 10.10.0.2/32
+    endpoint: peer.example.com:51820
+    persistentKeepalive: 25
+`
+
+	name := "test-wireguard"
+	cleanUp := func() {
+		kapp.RunWithOpts([]string{"delete", "-a", name}, RunOpts{AllowError: true})
+	}
+
+	cleanUp()
+	defer cleanUp()
+
+	logger.Section("Deploy", func() {
+		kapp.RunWithOpts([]string{"deploy", "-f", "-", "-a", name},
+			RunOpts{IntoNs: true, StdinReader: strings.NewReader(yaml1)})
+	})
+
+	gatewaySecret := waitForSecretObj(t, kubectl, "wg-gateway")
+	peerSecret := waitForSecretObj(t, kubectl, "wg-peer")
+
+	logger.Section("Check keys", func() {
+		require.Equal(t, corev1.SecretTypeOpaque, gatewaySecret.Type)
+		require.Len(t, gatewaySecret.Data, 2)
+		require.Len(t, peerSecret.Data, 3)
+
+		for _, key := range []string{"privatekey", "publickey", "presharedkey"} {
+			bs, err := base64.StdEncoding.DecodeString(string(peerSecret.Data[key]))
+			require.NoError(t, err)
+			require.Len(t, bs, 32)
+		}
+
+		out := kubectl.Run([]string{"get", "wireguardkey", "wg-gateway", "-o", "jsonpath={.status.publicKey}"})
+		require.Equal(t, string(gatewaySecret.Data["publickey"]), out)
+	})
+
+	logger.Section("Check config", func() {
+		secret := waitForSecretObj(t, kubectl, "wg-config")
+
+		expectedConfig := fmt.Sprintf(`[Interface]
+PrivateKey = %s
+Address = 10.10.0.1/24
+ListenPort = 51820
+
+# peer
+[Peer]
+PublicKey = %s
+PresharedKey = %s
+AllowedIPs = 10.10.0.2/32
+Endpoint = peer.example.com:51820
+PersistentKeepalive = 25
+`, gatewaySecret.Data["privatekey"], peerSecret.Data["publickey"], peerSecret.Data["presharedkey"])
+
+		require.Equal(t, expectedConfig, string(secret.Data["wg0.conf"]))
+
+		out := kubectl.Run([]string{"get", "wireguardconfig", "wg-config", "-o", "jsonpath={.status.publicKey}"})
+		require.Equal(t, string(gatewaySecret.Data["publickey"]), out)
+	})
+
+	logger.Section("Re-render config when peer key changes", func() {
+		newPublicKey := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+
+		kubectl.Run([]string{"patch", "secret", "wg-peer", "--type", "merge",
+			"-p", fmt.Sprintf(`{"stringData":{"publickey":"%s"}}`, newPublicKey)})
+
+		var config string
+
+		for i := 0; i < 30; i++ {
+			config = string(waitForSecretObj(t, kubectl, "wg-config").Data["wg0.conf"])
+			if strings.Contains(config, newPublicKey) {
+				break
+			}
+			time.Sleep(time.Second)
+		}
+
+		require.Contains(t, config, "PublicKey = "+newPublicKey+"\n")
+	})
+}